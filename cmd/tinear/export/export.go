@@ -0,0 +1,95 @@
+package export
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	exporter "github.com/sayedmurtaza24/tinear/pkg/export"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+// Run handles `tinear export`, issues are read from the local store so it
+// exports what the dashboard showed after the last sync.
+func Run(s *store.Store, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	format := flags.String("format", "csv", "csv, json or markdown")
+	output := flags.String("o", "", "file to write to instead of stdout")
+	project := flags.String("project", "", "only export the issues of this project")
+	search := flags.String("search", "", "only export the issues matching this search")
+	columns := flags.String("columns", "", "comma separated columns, defaults to the ones of the dashboard")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	f, err := exporter.ParseFormat(*format)
+	if err != nil {
+		return err
+	}
+
+	cols := exporter.DefaultColumns
+
+	if *project != "" {
+		projects, err := s.Projects()
+		if err != nil {
+			return err
+		}
+
+		var found bool
+		for _, p := range projects {
+			if strings.EqualFold(p.Name, *project) {
+				s.SetProject(&p)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("project %s doesn't exist", *project)
+		}
+
+		// NOTE: same as the project view, the milestone takes the project's place
+		cols = append([]string{"milestone"}, cols[1:]...)
+	}
+
+	if *columns != "" {
+		cols = strings.Split(*columns, ",")
+		for i := range cols {
+			cols[i] = strings.TrimSpace(cols[i])
+		}
+	}
+
+	var issues []store.Issue
+	if *search != "" {
+		issues, err = s.SearchIssues(*search)
+	} else {
+		issues, err = s.Issues()
+	}
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("couldn't create %s: %w", *output, err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	err = exporter.Write(w, f, cols, issues)
+	if err != nil {
+		return err
+	}
+
+	if *output != "" {
+		fmt.Fprintf(os.Stderr, "exported %d issues to %s\n", len(issues), *output)
+	}
+
+	return nil
+}
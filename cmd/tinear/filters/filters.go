@@ -0,0 +1,178 @@
+package filters
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+const usage = "usage: tinear filters list | export [-o filters.json] | import <filters.json> | delete <name>"
+
+// filter is a saved filter as it's shared, projects go by name since ids
+// mean nothing to whoever reads the file.
+type filter struct {
+	Name       string `json:"name"`
+	Query      string `json:"query"`
+	Sort       string `json:"sort,omitempty"`
+	Descending bool   `json:"descending,omitempty"`
+	Project    string `json:"project,omitempty"`
+}
+
+// Run handles `tinear filters <subcommand>` for the saved filters of the
+// active org.
+func Run(s *store.Store, args []string) error {
+	if len(args) == 0 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "list":
+		return list(s)
+	case "export":
+		return export(s, args[1:])
+	case "import":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		return importFile(s, args[1])
+	case "delete":
+		if len(args) != 2 {
+			return errors.New(usage)
+		}
+		return s.DeleteSavedFilter(args[1])
+	}
+
+	return errors.New(usage)
+}
+
+func list(s *store.Store) error {
+	saved, err := s.SavedFilters()
+	if err != nil {
+		return err
+	}
+
+	for i, f := range saved {
+		fmt.Printf("%d\t%s\t%s\n", i+1, f.Name, f.Query)
+	}
+
+	return nil
+}
+
+func export(s *store.Store, args []string) error {
+	flags := flag.NewFlagSet("filters export", flag.ContinueOnError)
+	output := flags.String("o", "", "file to write to instead of stdout")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	saved, err := s.SavedFilters()
+	if err != nil {
+		return err
+	}
+
+	filters := make([]filter, 0, len(saved))
+	for _, f := range saved {
+		filters = append(filters, filter{
+			Name:       f.Name,
+			Query:      f.Query,
+			Sort:       f.SortMode.String(),
+			Descending: f.Descending,
+			Project:    f.ProjectName,
+		})
+	}
+
+	var w io.Writer = os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return fmt.Errorf("couldn't create %s: %w", *output, err)
+		}
+		defer file.Close()
+		w = file
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+
+	err = enc.Encode(filters)
+	if err != nil {
+		return fmt.Errorf("couldn't encode saved filters: %w", err)
+	}
+
+	return nil
+}
+
+func importFile(s *store.Store, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("couldn't read %s: %w", path, err)
+	}
+
+	var filters []filter
+	err = json.Unmarshal(data, &filters)
+	if err != nil {
+		return fmt.Errorf("couldn't parse %s: %w", path, err)
+	}
+
+	projects, err := s.Projects()
+	if err != nil {
+		return err
+	}
+
+	var saved []store.SavedFilter
+	for _, f := range filters {
+		if f.Name == "" {
+			return errors.New("every saved filter needs a name")
+		}
+
+		// NOTE: a filter that doesn't parse would only show its error once picked
+		_, err := store.ParseQuery(f.Query)
+		if err != nil {
+			return fmt.Errorf("filter %s: %w", f.Name, err)
+		}
+
+		sf := store.SavedFilter{
+			Name:       f.Name,
+			Query:      f.Query,
+			Descending: f.Descending,
+		}
+
+		if f.Sort != "" {
+			sf.SortMode, err = store.ParseSortMode(f.Sort)
+			if err != nil {
+				return fmt.Errorf("filter %s: %w", f.Name, err)
+			}
+		}
+
+		if f.Project != "" {
+			for _, p := range projects {
+				if strings.EqualFold(p.Name, f.Project) {
+					sf.ProjectID = p.ID
+				}
+			}
+			if sf.ProjectID == "" {
+				return fmt.Errorf("filter %s: project %s doesn't exist", f.Name, f.Project)
+			}
+		}
+
+		saved = append(saved, sf)
+	}
+
+	err = s.StoreSavedFilters(saved)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "imported %d saved filters\n", len(saved))
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sayedmurtaza24/tinear/pkg/git"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+var ErrNoCurrentIssue = errors.New("no current issue")
+
+// CurrentIssue prints the identifier of the issue the checked out branch
+// belongs to, falling back to the issue marked as being worked on.
+func CurrentIssue(s *store.Store) error {
+	branch, err := git.CurrentBranch()
+	if err != nil && !errors.Is(err, git.ErrNotARepo) {
+		return err
+	}
+
+	if branch != "" {
+		issueID, err := s.IssueIDByBranch(branch)
+		if err != nil && !errors.Is(err, store.ErrNoOrgSelected) {
+			return err
+		}
+
+		if issueID != "" {
+			issue, err := s.Issue(issueID)
+			if err != nil {
+				return err
+			}
+			fmt.Println(issue.Identifier)
+			return nil
+		}
+
+		// NOTE: the branch may belong to an issue that isn't synced yet
+		for _, identifier := range git.Identifiers(branch) {
+			prefix, _, _ := strings.Cut(identifier, "-")
+			known, err := s.HasIdentifierPrefix(prefix)
+			if err != nil && !errors.Is(err, store.ErrNoOrgSelected) {
+				return err
+			}
+			if known {
+				fmt.Println(identifier)
+				return nil
+			}
+		}
+	}
+
+	issue, err := s.WorkingOn()
+	if err != nil && !errors.Is(err, store.ErrNoOrgSelected) {
+		return err
+	}
+
+	if issue == nil {
+		return ErrNoCurrentIssue
+	}
+
+	fmt.Println(issue.Identifier)
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package hooks
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sayedmurtaza24/tinear/pkg/git"
+)
+
+// marks hooks tinear wrote, those are the only ones it replaces
+const hookMarker = "# installed by tinear"
+
+const prepareCommitMsg = `#!/bin/sh
+` + hookMarker + `
+
+# merges, squashes and amends already have their message
+case "$2" in
+	merge|squash|commit) exit 0 ;;
+esac
+
+identifier=$(%[1]s current-issue 2>/dev/null) || exit 0
+[ -z "$identifier" ] && exit 0
+
+# don't mention it twice
+grep -v '^#' "$1" | grep -qi "$identifier" && exit 0
+
+%[2]s
+`
+
+const prepend = `sed -i.tinear "1s/^/$identifier /" "$1" && rm -f "$1.tinear"`
+
+const appendTrailer = `git interpret-trailers --in-place --trailer "Refs: $identifier" "$1"`
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// Run handles `tinear hooks <subcommand>`.
+func Run(args []string) error {
+	if len(args) == 0 || args[0] != "install" {
+		return errors.New("usage: tinear hooks install [-append] [-force]")
+	}
+
+	flags := flag.NewFlagSet("hooks install", flag.ContinueOnError)
+	appendRef := flags.Bool("append", false, "add the identifier as a Refs trailer instead of prefixing the subject")
+	force := flags.Bool("force", false, "replace a prepare-commit-msg hook tinear didn't install")
+
+	err := flags.Parse(args[1:])
+	if err != nil {
+		return err
+	}
+
+	dir, err := git.HooksDir()
+	if err != nil {
+		return err
+	}
+
+	path := filepath.Join(dir, "prepare-commit-msg")
+
+	existing, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("couldn't read existing hook: %w", err)
+	}
+	if err == nil && !bytes.Contains(existing, []byte(hookMarker)) && !*force {
+		return fmt.Errorf("%s already exists, use -force to replace it", path)
+	}
+
+	// the absolute path keeps working for git clients that don't share our PATH
+	bin, err := os.Executable()
+	if err != nil {
+		bin = "tinear"
+	}
+
+	action := prepend
+	if *appendRef {
+		action = appendTrailer
+	}
+
+	err = os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return fmt.Errorf("couldn't create hooks dir: %w", err)
+	}
+
+	err = os.WriteFile(path, []byte(fmt.Sprintf(prepareCommitMsg, shellQuote(bin), action)), 0o755)
+	if err != nil {
+		return fmt.Errorf("couldn't write hook: %w", err)
+	}
+
+	// WriteFile keeps the mode of a file that was already there
+	err = os.Chmod(path, 0o755)
+	if err != nil {
+		return fmt.Errorf("couldn't make hook executable: %w", err)
+	}
+
+	fmt.Printf("installed %s\n", path)
+
+	return nil
+}
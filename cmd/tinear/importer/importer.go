@@ -0,0 +1,319 @@
+package importer
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+const usage = "usage: tinear import <file.csv> [-team ENG] [-map title=Summary,...] [-batch 10] [-dry-run]"
+
+type row struct {
+	line  int
+	key   string
+	issue client.NewIssue
+}
+
+// progress remembers the rows already created by a hash of their record, so
+// an interrupted import continues where it stopped.
+type progress struct {
+	path    string
+	Created map[string]string `json:"created"`
+}
+
+func loadProgress(path string) (*progress, error) {
+	p := &progress{path: path, Created: make(map[string]string)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read import progress: %w", err)
+	}
+
+	err = json.Unmarshal(data, p)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse import progress %s: %w", path, err)
+	}
+
+	return p, nil
+}
+
+func (p *progress) save() error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf("couldn't encode import progress: %w", err)
+	}
+
+	tmp := p.path + ".tmp"
+	err = os.WriteFile(tmp, data, 0o644)
+	if err != nil {
+		return fmt.Errorf("couldn't write import progress: %w", err)
+	}
+
+	err = os.Rename(tmp, p.path)
+	if err != nil {
+		return fmt.Errorf("couldn't replace import progress: %w", err)
+	}
+
+	return nil
+}
+
+func recordKey(record []string) string {
+	sum := sha256.Sum256([]byte(strings.Join(record, "\x00")))
+	return hex.EncodeToString(sum[:8])
+}
+
+func resolveTeam(s *store.Store, name string) (string, error) {
+	teams, err := s.Teams()
+	if err != nil {
+		return "", err
+	}
+
+	if name == "" {
+		if len(teams) != 1 {
+			return "", errors.New("pick the team to import into with -team")
+		}
+		return teams[0].ID, nil
+	}
+
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return team.ID, nil
+		}
+	}
+
+	teamID, err := s.TeamIDByKey(strings.ToUpper(name))
+	if err != nil {
+		return "", err
+	}
+	if teamID == "" {
+		return "", fmt.Errorf("team %s doesn't exist", name)
+	}
+
+	return teamID, nil
+}
+
+// Run handles `tinear import`, names in the file are resolved against the
+// local store so it has to be synced first.
+func Run(s *store.Store, c *client.Client, args []string) error {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	team := flags.String("team", "", "name or key of the team to create the issues in")
+	mapping := flags.String("map", "", "comma separated <field>=<column> pairs for columns with other names")
+	batch := flags.Int("batch", 10, "number of issues created at once")
+	dryRun := flags.Bool("dry-run", false, "only report what would be created and what doesn't resolve")
+
+	err := flags.Parse(args)
+	if err != nil {
+		return err
+	}
+
+	// NOTE: flags may come after the file too, the flag package stops at the first argument
+	if flags.NArg() == 0 {
+		return errors.New(usage)
+	}
+	path := flags.Arg(0)
+
+	err = flags.Parse(flags.Args()[1:])
+	if err != nil {
+		return err
+	}
+	if flags.NArg() > 0 {
+		return errors.New(usage)
+	}
+
+	columnMapping, err := parseMapping(*mapping)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("couldn't open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return fmt.Errorf("couldn't read csv header: %w", err)
+	}
+
+	columns, err := mapColumns(header, columnMapping)
+	if err != nil {
+		return err
+	}
+
+	teamID, err := resolveTeam(s, *team)
+	if err != nil {
+		return err
+	}
+
+	r, err := newResolver(s, teamID)
+	if err != nil {
+		return err
+	}
+
+	prog, err := loadProgress(path + ".progress")
+	if err != nil {
+		return err
+	}
+
+	var rows []row
+	var skipped int
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("couldn't read csv: %w", err)
+		}
+
+		key := recordKey(record)
+		if _, ok := prog.Created[key]; ok {
+			skipped++
+			continue
+		}
+
+		issue := r.resolve(line, func(field string) string {
+			i, ok := columns[field]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		})
+
+		rows = append(rows, row{line: line, key: key, issue: issue})
+	}
+
+	if skipped > 0 {
+		fmt.Fprintf(os.Stderr, "skipping %d issues imported before\n", skipped)
+	}
+
+	if len(r.order) > 0 {
+		fmt.Fprint(os.Stderr, r.report())
+	}
+
+	if *dryRun {
+		fmt.Fprintf(os.Stderr, "would create %d issues\n", len(rows))
+		return nil
+	}
+
+	if len(r.order) > 0 {
+		return errors.New("fix or map the values above before importing, see -dry-run")
+	}
+
+	return create(c, prog, rows, max(*batch, 1))
+}
+
+type result struct {
+	row        row
+	identifier string
+	err        error
+}
+
+func create(c *client.Client, prog *progress, rows []row, batch int) error {
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	// NOTE: the batch in flight is left to finish, linear may have created its
+	// issues already and they'd be created again if they never made it into
+	// the progress
+	var interrupted atomic.Bool
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-interrupt:
+			interrupted.Store(true)
+			fmt.Fprintln(os.Stderr, "interrupted, finishing the issues being created")
+		case <-done:
+		}
+	}()
+
+	var created []result
+	var failed []result
+	var attempted int
+
+	for attempted < len(rows) && !interrupted.Load() {
+		end := min(attempted+batch, len(rows))
+
+		results := make([]result, end-attempted)
+
+		var wg sync.WaitGroup
+		for i, r := range rows[attempted:end] {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				results[i].row = r
+				switch msg := c.CreateIssue(r.issue)().(type) {
+				case error:
+					results[i].err = msg
+				case client.CreateIssueRes:
+					results[i].identifier = msg.Result.Identifier
+				default:
+					results[i].err = errors.New("linear didn't answer")
+				}
+			}()
+		}
+		wg.Wait()
+		attempted = end
+
+		for _, res := range results {
+			switch {
+			case res.err != nil:
+				failed = append(failed, res)
+			case res.identifier != "":
+				prog.Created[res.row.key] = res.identifier
+				created = append(created, res)
+			}
+		}
+
+		err := prog.save()
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(os.Stderr, "created %d/%d\n", len(created), len(rows))
+	}
+
+	for _, res := range created {
+		fmt.Printf("%s\t%s\n", res.identifier, res.row.issue.Title)
+	}
+
+	for _, res := range failed {
+		fmt.Fprintf(os.Stderr, "line %d: %v\n", res.row.line, res.err)
+	}
+
+	switch {
+	case attempted < len(rows):
+		return fmt.Errorf("import interrupted after %d issues, run it again to continue", len(created))
+	case len(failed) > 0:
+		return fmt.Errorf("%d issues couldn't be created, run the import again to retry them", len(failed))
+	}
+
+	fmt.Fprintf(os.Stderr, "imported %d issues, progress is kept in %s\n", len(created), prog.path)
+
+	return nil
+}
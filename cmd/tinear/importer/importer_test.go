@@ -0,0 +1,132 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+// linearStub creates issues in place of linear, interrupting the import
+// while the first request of the first batch is in flight when asked to.
+type linearStub struct {
+	mu        sync.Mutex
+	created   map[string]int
+	interrupt bool
+}
+
+func (l *linearStub) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body struct {
+		Variables struct {
+			Input struct {
+				Title string `json:"title"`
+			} `json:"input"`
+		} `json:"variables"`
+	}
+	err := json.NewDecoder(req.Body).Decode(&body)
+	if err != nil {
+		return nil, err
+	}
+
+	l.mu.Lock()
+	interrupt := l.interrupt
+	l.interrupt = false
+	l.created[body.Variables.Input.Title]++
+	n := len(l.created)
+	l.mu.Unlock()
+
+	if interrupt {
+		process, err := os.FindProcess(os.Getpid())
+		if err != nil {
+			return nil, err
+		}
+		err = process.Signal(os.Interrupt)
+		if err != nil {
+			return nil, err
+		}
+		// the response comes after the interrupt is handled
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body: io.NopCloser(strings.NewReader(fmt.Sprintf(
+			`{"data": {"issueCreate": {"success": true, "issue": {"id": "issue-%d", "identifier": "ENG-%d"}}}}`, n, n,
+		))),
+	}, nil
+}
+
+func TestResumeImport(t *testing.T) {
+	s, err := store.New(filepath.Join(t.TempDir(), "tinear.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	_, err = s.StoreOrg(store.Org{ID: "0b9f5c2e-org", Name: "Org", URLKey: "org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.StoreTeams([]store.Team{{ID: "team", Name: "Engineering", Color: "#5e6ad2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stub := &linearStub{created: make(map[string]int), interrupt: true}
+
+	transport := http.DefaultTransport
+	http.DefaultTransport = stub
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	path := filepath.Join(t.TempDir(), "issues.csv")
+	err = os.WriteFile(path, []byte("title\nFirst\nSecond\nThird\nFourth\nFifth\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := client.New(config.Workspace{Name: "work", APIKey: "key"})
+	args := []string{path, "-team", "engineering", "-batch", "2"}
+
+	err = Run(s, c, args)
+	if err == nil || !strings.Contains(err.Error(), "interrupted") {
+		t.Fatalf("expected the import to be interrupted, got %v", err)
+	}
+
+	prog, err := loadProgress(path + ".progress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prog.Created) != 2 || len(stub.created) != 2 {
+		t.Fatalf("expected the batch in flight to finish and be saved, %d created and %d saved", len(stub.created), len(prog.Created))
+	}
+
+	err = Run(s, c, args)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, title := range []string{"First", "Second", "Third", "Fourth", "Fifth"} {
+		if n := stub.created[title]; n != 1 {
+			t.Errorf("expected %s to be created once, it was created %d times", title, n)
+		}
+	}
+
+	prog, err = loadProgress(path + ".progress")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prog.Created) != 5 {
+		t.Fatalf("expected all 5 issues in the progress, got %d", len(prog.Created))
+	}
+}
@@ -0,0 +1,243 @@
+package importer
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+var fields = []string{"title", "description", "state", "priority", "assignee", "labels", "project"}
+
+// headers a field is recognized by when no mapping was given for it
+var fieldAliases = map[string][]string{
+	"title":       {"title", "summary", "name", "subject"},
+	"description": {"description", "body", "details"},
+	"state":       {"state", "status"},
+	"priority":    {"priority", "prio"},
+	"assignee":    {"assignee", "assignee email", "assignee_email", "email", "owner"},
+	"labels":      {"labels", "label", "tags"},
+	"project":     {"project"},
+}
+
+// mapColumns finds the column index of every field, mapping holds the
+// headers given on the command line by field.
+func mapColumns(header []string, mapping map[string]string) (map[string]int, error) {
+	columns := make(map[string]int)
+
+	find := func(name string) int {
+		return slices.IndexFunc(header, func(h string) bool {
+			return strings.EqualFold(strings.TrimSpace(h), name)
+		})
+	}
+
+	for _, field := range fields {
+		if name, ok := mapping[field]; ok {
+			i := find(name)
+			if i == -1 {
+				return nil, fmt.Errorf("column %s mapped to %s doesn't exist", name, field)
+			}
+			columns[field] = i
+			continue
+		}
+
+		for _, alias := range fieldAliases[field] {
+			if i := find(alias); i != -1 {
+				columns[field] = i
+				break
+			}
+		}
+	}
+
+	if _, ok := columns["title"]; !ok {
+		return nil, fmt.Errorf("no title column found, map one with -map title=<column>")
+	}
+
+	return columns, nil
+}
+
+func parseMapping(s string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	if s == "" {
+		return mapping, nil
+	}
+
+	for _, pair := range strings.Split(s, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		if !ok || !slices.Contains(fields, field) {
+			return nil, fmt.Errorf("invalid mapping %q, use <field>=<column> with one of %s", pair, strings.Join(fields, ", "))
+		}
+		mapping[field] = strings.TrimSpace(column)
+	}
+
+	return mapping, nil
+}
+
+type resolver struct {
+	teamID   string
+	states   []store.State
+	users    []store.User
+	labels   []store.Label
+	projects []store.Project
+
+	// lines of every value that couldn't be resolved, in order of appearance
+	unresolved map[string][]int
+	order      []string
+}
+
+func newResolver(s *store.Store, teamID string) (*resolver, error) {
+	states, err := s.States(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.Users()
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := s.Labels(teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.Projects()
+	if err != nil {
+		return nil, err
+	}
+
+	return &resolver{
+		teamID:     teamID,
+		states:     states,
+		users:      users,
+		labels:     labels,
+		projects:   projects,
+		unresolved: make(map[string][]int),
+	}, nil
+}
+
+func (r *resolver) fail(line int, field, value string) {
+	key := fmt.Sprintf("unresolved %s %q", field, value)
+	if value == "" {
+		key = "missing " + field
+	}
+	if _, ok := r.unresolved[key]; !ok {
+		r.order = append(r.order, key)
+	}
+	r.unresolved[key] = append(r.unresolved[key], line)
+}
+
+func parsePriority(s string) (int64, bool) {
+	if n, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return n, n >= 0 && n <= 4
+	}
+
+	switch strings.ToLower(s) {
+	case "", "none", "no priority":
+		return 0, true
+	case "urgent":
+		return 1, true
+	case "high":
+		return 2, true
+	case "medium", "normal":
+		return 3, true
+	case "low":
+		return 4, true
+	}
+
+	return 0, false
+}
+
+func splitLabels(s string) []string {
+	var names []string
+	for _, name := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' || r == '|' }) {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// resolve turns a record into an issue, values that don't resolve are
+// recorded and left out.
+func (r *resolver) resolve(line int, value func(field string) string) client.NewIssue {
+	issue := client.NewIssue{
+		Title:       value("title"),
+		Description: value("description"),
+		TeamID:      r.teamID,
+	}
+
+	if issue.Title == "" {
+		r.fail(line, "title", "")
+	}
+
+	if name := value("state"); name != "" {
+		i := slices.IndexFunc(r.states, func(s store.State) bool { return strings.EqualFold(s.Name, name) })
+		if i == -1 {
+			r.fail(line, "state", name)
+		} else {
+			issue.StateID = r.states[i].ID
+		}
+	}
+
+	priority, ok := parsePriority(value("priority"))
+	if !ok {
+		r.fail(line, "priority", value("priority"))
+	}
+	issue.Priority = priority
+
+	if assignee := value("assignee"); assignee != "" {
+		i := slices.IndexFunc(r.users, func(u store.User) bool {
+			return strings.EqualFold(u.Email, assignee) || strings.EqualFold(u.DisplayName, assignee) || strings.EqualFold(u.Name, assignee)
+		})
+		if i == -1 {
+			r.fail(line, "assignee", assignee)
+		} else {
+			issue.AssigneeID = r.users[i].ID
+		}
+	}
+
+	for _, name := range splitLabels(value("labels")) {
+		i := slices.IndexFunc(r.labels, func(l store.Label) bool { return strings.EqualFold(l.Name, name) })
+		if i == -1 {
+			r.fail(line, "label", name)
+			continue
+		}
+		if !slices.Contains(issue.LabelIDs, r.labels[i].ID) {
+			issue.LabelIDs = append(issue.LabelIDs, r.labels[i].ID)
+		}
+	}
+
+	if name := value("project"); name != "" {
+		i := slices.IndexFunc(r.projects, func(p store.Project) bool { return strings.EqualFold(p.Name, name) })
+		if i == -1 {
+			r.fail(line, "project", name)
+		} else {
+			issue.ProjectID = r.projects[i].ID
+		}
+	}
+
+	return issue
+}
+
+func (r *resolver) report() string {
+	var b strings.Builder
+	for _, key := range r.order {
+		lines := r.unresolved[key]
+
+		var shown []string
+		for _, line := range lines[:min(len(lines), 10)] {
+			shown = append(shown, strconv.Itoa(line))
+		}
+		if len(lines) > 10 {
+			shown = append(shown, fmt.Sprintf("and %d more", len(lines)-10))
+		}
+
+		fmt.Fprintf(&b, "  %s on line %s\n", key, strings.Join(shown, ", "))
+	}
+	return b.String()
+}
@@ -0,0 +1,87 @@
+package login
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"time"
+
+	"github.com/sayedmurtaza24/tinear/pkg/auth"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+)
+
+func openInBrowser(url string) error {
+	fmt.Printf("opening %s\n", url)
+
+	var cmd string
+	var args []string
+
+	switch runtime.GOOS {
+	case "windows":
+		cmd = "cmd"
+		args = []string{"/c", "start"}
+	case "darwin":
+		cmd = "open"
+	default:
+		cmd = "xdg-open"
+	}
+	args = append(args, url)
+
+	// NOTE: on headless machines the printed url is all we've got
+	if err := exec.Command(cmd, args...).Start(); err != nil {
+		fmt.Println("couldn't open a browser, visit the url above to continue")
+	}
+
+	return nil
+}
+
+// Run logs into the workspace named in args, or the first configured one.
+func Run(cfg *config.Config, args []string) error {
+	workspace := cfg.Workspaces[0]
+	if len(args) > 0 {
+		ws, ok := cfg.Workspace(args[0])
+		if !ok {
+			return fmt.Errorf("workspace %s is not configured", args[0])
+		}
+		workspace = ws
+	}
+
+	if workspace.APIKey != "" {
+		fmt.Printf("workspace %s has an api key configured, it's used instead of the login\n", workspace.Name)
+	}
+
+	path, err := config.CredentialsPath()
+	if err != nil {
+		return err
+	}
+
+	creds, err := auth.LoadCredentials(path)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	token, err := auth.Login(ctx, workspace.OAuth, openInBrowser)
+	if err != nil {
+		return fmt.Errorf("couldn't login: %w", err)
+	}
+
+	creds[workspace.Name] = token
+
+	err = auth.SaveCredentials(path, creds)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("logged into workspace %s\n", workspace.Name)
+
+	return nil
+}
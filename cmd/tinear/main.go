@@ -1,15 +1,101 @@
 package main
 
 import (
+	"fmt"
 	"log/slog"
+	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/cmd/tinear/export"
+	"github.com/sayedmurtaza24/tinear/cmd/tinear/filters"
+	"github.com/sayedmurtaza24/tinear/cmd/tinear/hooks"
+	"github.com/sayedmurtaza24/tinear/cmd/tinear/importer"
+	"github.com/sayedmurtaza24/tinear/cmd/tinear/login"
 	"github.com/sayedmurtaza24/tinear/cmd/tinear/show"
 	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 )
 
+const storePath = "/tmp/tinear"
+
+func runCommand(cfg *config.Config, command string, args []string) error {
+	switch command {
+	case "login":
+		return login.Run(cfg, args)
+	case "hooks":
+		return hooks.Run(args)
+	case "current-issue":
+		store, err := store.New(storePath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		return hooks.CurrentIssue(store)
+	case "export":
+		store, err := store.New(storePath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		return export.Run(store, args)
+	case "filters":
+		store, err := store.New(storePath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		return filters.Run(store, args)
+	case "import":
+		store, err := store.New(storePath)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		workspace, _ := workspaceOf(cfg, store)
+
+		return importer.Run(store, client.New(workspace), args)
+	default:
+		return fmt.Errorf("unknown command %s", command)
+	}
+}
+
 func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	if len(os.Args) > 1 {
+		err = runCommand(cfg, os.Args[1], os.Args[2:])
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// NOTE: bad keys and themes are reported before the screen is taken over,
+	// the background of the terminal can't be asked for after either
+	keys, err := keymap.Load(cfg.Keys)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	th, err := theme.Load(cfg.Theme)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
 	f, err := tea.LogToFile("/tmp/tinear.log", "DEBUG")
 	if err != nil {
 		slog.Error("failed to setup logger", slog.Any("error", err))
@@ -17,17 +103,51 @@ func main() {
 	}
 	defer f.Close()
 
-	store, err := store.New("/tmp/tinear")
+	store, err := store.New(storePath)
 	if err != nil {
 		slog.Error("failed to setup store", slog.Any("error", err))
 		return
 	}
 
-	client := client.New()
-	model := show.New(store, client)
+	workspace, ok := workspaceOf(cfg, store)
+
+	// NOTE: an org cached before workspaces existed is claimed on the next GetMe
+	if !ok && store.Current().Org.Workspace != "" {
+		err = store.SetActiveOrg(activeOrgOf(store, workspace))
+		if err != nil {
+			slog.Error("failed to select workspace", slog.Any("error", err))
+			return
+		}
+	}
+
+	client := client.New(workspace)
+	model := show.New(store, client, cfg, keys, th)
 
 	_, err = tea.NewProgram(model, tea.WithAltScreen()).Run()
 	if err != nil {
 		slog.Error("failed to start tinear", slog.Any("error", err))
 	}
 }
+
+// workspaceOf is the workspace that was active last time, ok is false when
+// the active org isn't bound to a configured one.
+func workspaceOf(cfg *config.Config, s *store.Store) (workspace config.Workspace, ok bool) {
+	workspace, ok = cfg.Workspace(s.Current().Org.Workspace)
+	if !ok {
+		workspace = cfg.Workspaces[0]
+	}
+	return workspace, ok
+}
+
+func activeOrgOf(s *store.Store, workspace config.Workspace) string {
+	orgs, err := s.Orgs()
+	if err != nil {
+		return ""
+	}
+	for _, org := range orgs {
+		if org.Workspace == workspace.Name {
+			return org.ID
+		}
+	}
+	return ""
+}
@@ -4,7 +4,10 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/views/dashboard"
 )
 
@@ -12,9 +15,9 @@ type model struct {
 	dashboard *dashboard.Model
 }
 
-func New(store *store.Store, client *client.Client) *model {
+func New(store *store.Store, client *client.Client, cfg *config.Config, keys keymap.KeyMap, th theme.Theme) *model {
 	return &model{
-		dashboard: dashboard.New(store, client),
+		dashboard: dashboard.New(store, client, cfg, keys, th),
 	}
 }
 
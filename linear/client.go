@@ -16,6 +16,14 @@ type LinearClient interface {
 	GetProjects(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetProjects, error)
 	GetAllUsers(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetAllUsers, error)
 	GetMe(ctx context.Context, interceptors ...clientv2.RequestInterceptor) (*GetMe, error)
+	GetNotifications(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetNotifications, error)
+	UpdateNotification(ctx context.Context, id string, input models.NotificationUpdateInput, interceptors ...clientv2.RequestInterceptor) (*UpdateNotification, error)
+	GetCustomViews(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetCustomViews, error)
+	ArchiveIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*ArchiveIssue, error)
+	DeleteIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*DeleteIssue, error)
+	UnarchiveIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*UnarchiveIssue, error)
+	CreateIssue(ctx context.Context, input models.IssueCreateInput, interceptors ...clientv2.RequestInterceptor) (*CreateIssue, error)
+	GetIssueActivity(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetIssueActivity, error)
 }
 
 type Client struct {
@@ -126,11 +134,94 @@ func (t *GetIssues_Issues_Nodes_State_Team) GetID() string {
 	return t.ID
 }
 
+type GetIssues_Issues_Nodes_ProjectMilestone struct {
+	ID   string "json:\"id\" graphql:\"id\""
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssues_Issues_Nodes_ProjectMilestone) GetID() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_ProjectMilestone{}
+	}
+	return t.ID
+}
+func (t *GetIssues_Issues_Nodes_ProjectMilestone) GetName() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_ProjectMilestone{}
+	}
+	return t.Name
+}
+
+type GetIssues_Issues_Nodes_Cycle struct {
+	ID     string  "json:\"id\" graphql:\"id\""
+	Number float64 "json:\"number\" graphql:\"number\""
+	Name   *string "json:\"name,omitempty\" graphql:\"name\""
+}
+
+func (t *GetIssues_Issues_Nodes_Cycle) GetID() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Cycle{}
+	}
+	return t.ID
+}
+func (t *GetIssues_Issues_Nodes_Cycle) GetNumber() float64 {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Cycle{}
+	}
+	return t.Number
+}
+func (t *GetIssues_Issues_Nodes_Cycle) GetName() *string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Cycle{}
+	}
+	return t.Name
+}
+
+type GetIssues_Issues_Nodes_Creator struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	Name        string "json:\"name\" graphql:\"name\""
+	Email       string "json:\"email\" graphql:\"email\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+	IsMe        bool   "json:\"isMe\" graphql:\"isMe\""
+}
+
+func (t *GetIssues_Issues_Nodes_Creator) GetID() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Creator{}
+	}
+	return t.ID
+}
+func (t *GetIssues_Issues_Nodes_Creator) GetName() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Creator{}
+	}
+	return t.Name
+}
+func (t *GetIssues_Issues_Nodes_Creator) GetEmail() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Creator{}
+	}
+	return t.Email
+}
+func (t *GetIssues_Issues_Nodes_Creator) GetDisplayName() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Creator{}
+	}
+	return t.DisplayName
+}
+func (t *GetIssues_Issues_Nodes_Creator) GetIsMe() bool {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_Creator{}
+	}
+	return t.IsMe
+}
+
 type GetIssues_Issues_Nodes_State struct {
 	ID       string                            "json:\"id\" graphql:\"id\""
 	Name     string                            "json:\"name\" graphql:\"name\""
 	Color    string                            "json:\"color\" graphql:\"color\""
 	Position float64                           "json:\"position\" graphql:\"position\""
+	Type     string                            "json:\"type\" graphql:\"type\""
 	Team     GetIssues_Issues_Nodes_State_Team "json:\"team\" graphql:\"team\""
 }
 
@@ -158,6 +249,12 @@ func (t *GetIssues_Issues_Nodes_State) GetPosition() float64 {
 	}
 	return t.Position
 }
+func (t *GetIssues_Issues_Nodes_State) GetType() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes_State{}
+	}
+	return t.Type
+}
 func (t *GetIssues_Issues_Nodes_State) GetTeam() *GetIssues_Issues_Nodes_State_Team {
 	if t == nil {
 		t = &GetIssues_Issues_Nodes_State{}
@@ -220,19 +317,25 @@ func (t *GetIssues_Issues_Nodes_Labels) GetNodes() []*GetIssues_Issues_Nodes_Lab
 }
 
 type GetIssues_Issues_Nodes struct {
-	ID          string                           "json:\"id\" graphql:\"id\""
-	Identifier  string                           "json:\"identifier\" graphql:\"identifier\""
-	Title       string                           "json:\"title\" graphql:\"title\""
-	Priority    float64                          "json:\"priority\" graphql:\"priority\""
-	Description *string                          "json:\"description,omitempty\" graphql:\"description\""
-	Team        GetIssues_Issues_Nodes_Team      "json:\"team\" graphql:\"team\""
-	Assignee    *GetIssues_Issues_Nodes_Assignee "json:\"assignee,omitempty\" graphql:\"assignee\""
-	Project     *GetIssues_Issues_Nodes_Project  "json:\"project,omitempty\" graphql:\"project\""
-	State       GetIssues_Issues_Nodes_State     "json:\"state\" graphql:\"state\""
-	Labels      GetIssues_Issues_Nodes_Labels    "json:\"labels\" graphql:\"labels\""
-	CreatedAt   string                           "json:\"createdAt\" graphql:\"createdAt\""
-	UpdatedAt   string                           "json:\"updatedAt\" graphql:\"updatedAt\""
-	CanceledAt  *string                          "json:\"canceledAt,omitempty\" graphql:\"canceledAt\""
+	ID               string                                   "json:\"id\" graphql:\"id\""
+	Identifier       string                                   "json:\"identifier\" graphql:\"identifier\""
+	Title            string                                   "json:\"title\" graphql:\"title\""
+	BranchName       string                                   "json:\"branchName\" graphql:\"branchName\""
+	Priority         float64                                  "json:\"priority\" graphql:\"priority\""
+	Description      *string                                  "json:\"description,omitempty\" graphql:\"description\""
+	Team             GetIssues_Issues_Nodes_Team              "json:\"team\" graphql:\"team\""
+	Assignee         *GetIssues_Issues_Nodes_Assignee         "json:\"assignee,omitempty\" graphql:\"assignee\""
+	Project          *GetIssues_Issues_Nodes_Project          "json:\"project,omitempty\" graphql:\"project\""
+	ProjectMilestone *GetIssues_Issues_Nodes_ProjectMilestone "json:\"projectMilestone,omitempty\" graphql:\"projectMilestone\""
+	State            GetIssues_Issues_Nodes_State             "json:\"state\" graphql:\"state\""
+	Labels           GetIssues_Issues_Nodes_Labels            "json:\"labels\" graphql:\"labels\""
+	CreatedAt        string                                   "json:\"createdAt\" graphql:\"createdAt\""
+	UpdatedAt        string                                   "json:\"updatedAt\" graphql:\"updatedAt\""
+	CanceledAt       *string                                  "json:\"canceledAt,omitempty\" graphql:\"canceledAt\""
+	Estimate         *float64                                 "json:\"estimate,omitempty\" graphql:\"estimate\""
+	DueDate          *string                                  "json:\"dueDate,omitempty\" graphql:\"dueDate\""
+	Cycle            *GetIssues_Issues_Nodes_Cycle            "json:\"cycle,omitempty\" graphql:\"cycle\""
+	Creator          *GetIssues_Issues_Nodes_Creator          "json:\"creator,omitempty\" graphql:\"creator\""
 }
 
 func (t *GetIssues_Issues_Nodes) GetID() string {
@@ -253,6 +356,12 @@ func (t *GetIssues_Issues_Nodes) GetTitle() string {
 	}
 	return t.Title
 }
+func (t *GetIssues_Issues_Nodes) GetBranchName() string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.BranchName
+}
 func (t *GetIssues_Issues_Nodes) GetPriority() float64 {
 	if t == nil {
 		t = &GetIssues_Issues_Nodes{}
@@ -283,6 +392,12 @@ func (t *GetIssues_Issues_Nodes) GetProject() *GetIssues_Issues_Nodes_Project {
 	}
 	return t.Project
 }
+func (t *GetIssues_Issues_Nodes) GetProjectMilestone() *GetIssues_Issues_Nodes_ProjectMilestone {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.ProjectMilestone
+}
 func (t *GetIssues_Issues_Nodes) GetState() *GetIssues_Issues_Nodes_State {
 	if t == nil {
 		t = &GetIssues_Issues_Nodes{}
@@ -313,6 +428,30 @@ func (t *GetIssues_Issues_Nodes) GetCanceledAt() *string {
 	}
 	return t.CanceledAt
 }
+func (t *GetIssues_Issues_Nodes) GetEstimate() *float64 {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.Estimate
+}
+func (t *GetIssues_Issues_Nodes) GetDueDate() *string {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.DueDate
+}
+func (t *GetIssues_Issues_Nodes) GetCycle() *GetIssues_Issues_Nodes_Cycle {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.Cycle
+}
+func (t *GetIssues_Issues_Nodes) GetCreator() *GetIssues_Issues_Nodes_Creator {
+	if t == nil {
+		t = &GetIssues_Issues_Nodes{}
+	}
+	return t.Creator
+}
 
 type GetIssues_Issues_PageInfo struct {
 	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
@@ -397,11 +536,80 @@ func (t *GetProjects_Projects_Nodes_Teams) GetNodes() []*GetProjects_Projects_No
 	return t.Nodes
 }
 
+type GetProjects_Projects_Nodes_Lead struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetProjects_Projects_Nodes_Lead) GetID() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_Lead{}
+	}
+	return t.ID
+}
+func (t *GetProjects_Projects_Nodes_Lead) GetDisplayName() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_Lead{}
+	}
+	return t.DisplayName
+}
+
+type GetProjects_Projects_Nodes_ProjectMilestones_Nodes struct {
+	ID         string  "json:\"id\" graphql:\"id\""
+	Name       string  "json:\"name\" graphql:\"name\""
+	TargetDate *string "json:\"targetDate,omitempty\" graphql:\"targetDate\""
+	SortOrder  float64 "json:\"sortOrder\" graphql:\"sortOrder\""
+}
+
+func (t *GetProjects_Projects_Nodes_ProjectMilestones_Nodes) GetID() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_ProjectMilestones_Nodes{}
+	}
+	return t.ID
+}
+func (t *GetProjects_Projects_Nodes_ProjectMilestones_Nodes) GetName() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_ProjectMilestones_Nodes{}
+	}
+	return t.Name
+}
+func (t *GetProjects_Projects_Nodes_ProjectMilestones_Nodes) GetTargetDate() *string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_ProjectMilestones_Nodes{}
+	}
+	return t.TargetDate
+}
+func (t *GetProjects_Projects_Nodes_ProjectMilestones_Nodes) GetSortOrder() float64 {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_ProjectMilestones_Nodes{}
+	}
+	return t.SortOrder
+}
+
+type GetProjects_Projects_Nodes_ProjectMilestones struct {
+	Nodes []*GetProjects_Projects_Nodes_ProjectMilestones_Nodes "json:\"nodes\" graphql:\"nodes\""
+}
+
+func (t *GetProjects_Projects_Nodes_ProjectMilestones) GetNodes() []*GetProjects_Projects_Nodes_ProjectMilestones_Nodes {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes_ProjectMilestones{}
+	}
+	return t.Nodes
+}
+
 type GetProjects_Projects_Nodes struct {
-	Name  string                           "json:\"name\" graphql:\"name\""
-	ID    string                           "json:\"id\" graphql:\"id\""
-	Color string                           "json:\"color\" graphql:\"color\""
-	Teams GetProjects_Projects_Nodes_Teams "json:\"teams\" graphql:\"teams\""
+	Name              string                                       "json:\"name\" graphql:\"name\""
+	ID                string                                       "json:\"id\" graphql:\"id\""
+	Color             string                                       "json:\"color\" graphql:\"color\""
+	Description       string                                       "json:\"description\" graphql:\"description\""
+	State             string                                       "json:\"state\" graphql:\"state\""
+	StartDate         *string                                      "json:\"startDate,omitempty\" graphql:\"startDate\""
+	TargetDate        *string                                      "json:\"targetDate,omitempty\" graphql:\"targetDate\""
+	Progress          float64                                      "json:\"progress\" graphql:\"progress\""
+	Health            *models.ProjectUpdateHealthType              "json:\"health,omitempty\" graphql:\"health\""
+	Lead              *GetProjects_Projects_Nodes_Lead             "json:\"lead,omitempty\" graphql:\"lead\""
+	Teams             GetProjects_Projects_Nodes_Teams             "json:\"teams\" graphql:\"teams\""
+	ProjectMilestones GetProjects_Projects_Nodes_ProjectMilestones "json:\"projectMilestones\" graphql:\"projectMilestones\""
 }
 
 func (t *GetProjects_Projects_Nodes) GetName() string {
@@ -422,12 +630,60 @@ func (t *GetProjects_Projects_Nodes) GetColor() string {
 	}
 	return t.Color
 }
+func (t *GetProjects_Projects_Nodes) GetDescription() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.Description
+}
+func (t *GetProjects_Projects_Nodes) GetState() string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.State
+}
+func (t *GetProjects_Projects_Nodes) GetStartDate() *string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.StartDate
+}
+func (t *GetProjects_Projects_Nodes) GetTargetDate() *string {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.TargetDate
+}
+func (t *GetProjects_Projects_Nodes) GetProgress() float64 {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.Progress
+}
+func (t *GetProjects_Projects_Nodes) GetHealth() *models.ProjectUpdateHealthType {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.Health
+}
+func (t *GetProjects_Projects_Nodes) GetLead() *GetProjects_Projects_Nodes_Lead {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return t.Lead
+}
 func (t *GetProjects_Projects_Nodes) GetTeams() *GetProjects_Projects_Nodes_Teams {
 	if t == nil {
 		t = &GetProjects_Projects_Nodes{}
 	}
 	return &t.Teams
 }
+func (t *GetProjects_Projects_Nodes) GetProjectMilestones() *GetProjects_Projects_Nodes_ProjectMilestones {
+	if t == nil {
+		t = &GetProjects_Projects_Nodes{}
+	}
+	return &t.ProjectMilestones
+}
 
 type GetProjects_Projects_PageInfo struct {
 	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
@@ -566,9 +822,11 @@ func (t *GetMe_Viewer_Organization) GetURLKey() string {
 }
 
 type GetMe_Viewer_Teams_Nodes_States_Nodes struct {
-	ID    string "json:\"id\" graphql:\"id\""
-	Name  string "json:\"name\" graphql:\"name\""
-	Color string "json:\"color\" graphql:\"color\""
+	ID       string  "json:\"id\" graphql:\"id\""
+	Name     string  "json:\"name\" graphql:\"name\""
+	Color    string  "json:\"color\" graphql:\"color\""
+	Position float64 "json:\"position\" graphql:\"position\""
+	Type     string  "json:\"type\" graphql:\"type\""
 }
 
 func (t *GetMe_Viewer_Teams_Nodes_States_Nodes) GetID() string {
@@ -589,6 +847,18 @@ func (t *GetMe_Viewer_Teams_Nodes_States_Nodes) GetColor() string {
 	}
 	return t.Color
 }
+func (t *GetMe_Viewer_Teams_Nodes_States_Nodes) GetPosition() float64 {
+	if t == nil {
+		t = &GetMe_Viewer_Teams_Nodes_States_Nodes{}
+	}
+	return t.Position
+}
+func (t *GetMe_Viewer_Teams_Nodes_States_Nodes) GetType() string {
+	if t == nil {
+		t = &GetMe_Viewer_Teams_Nodes_States_Nodes{}
+	}
+	return t.Type
+}
 
 type GetMe_Viewer_Teams_Nodes_States struct {
 	Nodes []*GetMe_Viewer_Teams_Nodes_States_Nodes "json:\"nodes\" graphql:\"nodes\""
@@ -758,78 +1028,795 @@ func (t *GetMe_Viewer) GetTeams() *GetMe_Viewer_Teams {
 	return &t.Teams
 }
 
-type GetIssues struct {
-	Issues GetIssues_Issues "json:\"issues\" graphql:\"issues\""
+type GetNotifications_Notifications_Nodes_Actor struct {
+	ID          string "json:\"id\" graphql:\"id\""
+	Name        string "json:\"name\" graphql:\"name\""
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
 }
 
-func (t *GetIssues) GetIssues() *GetIssues_Issues {
+func (t *GetNotifications_Notifications_Nodes_Actor) GetID() string {
 	if t == nil {
-		t = &GetIssues{}
+		t = &GetNotifications_Notifications_Nodes_Actor{}
 	}
-	return &t.Issues
+	return t.ID
+}
+func (t *GetNotifications_Notifications_Nodes_Actor) GetName() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes_Actor{}
+	}
+	return t.Name
+}
+func (t *GetNotifications_Notifications_Nodes_Actor) GetDisplayName() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes_Actor{}
+	}
+	return t.DisplayName
 }
 
-type BatchUpdateIssues struct {
-	IssueBatchUpdate BatchUpdateIssues_IssueBatchUpdate "json:\"issueBatchUpdate\" graphql:\"issueBatchUpdate\""
+type GetNotifications_Notifications_Nodes_IssueNotification_Issue struct {
+	ID         string "json:\"id\" graphql:\"id\""
+	Identifier string "json:\"identifier\" graphql:\"identifier\""
 }
 
-func (t *BatchUpdateIssues) GetIssueBatchUpdate() *BatchUpdateIssues_IssueBatchUpdate {
+func (t *GetNotifications_Notifications_Nodes_IssueNotification_Issue) GetID() string {
 	if t == nil {
-		t = &BatchUpdateIssues{}
+		t = &GetNotifications_Notifications_Nodes_IssueNotification_Issue{}
 	}
-	return &t.IssueBatchUpdate
+	return t.ID
+}
+func (t *GetNotifications_Notifications_Nodes_IssueNotification_Issue) GetIdentifier() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes_IssueNotification_Issue{}
+	}
+	return t.Identifier
 }
 
-type GetProjects struct {
-	Projects GetProjects_Projects "json:\"projects\" graphql:\"projects\""
+type GetNotifications_Notifications_Nodes_IssueNotification struct {
+	Issue GetNotifications_Notifications_Nodes_IssueNotification_Issue "json:\"issue\" graphql:\"issue\""
 }
 
-func (t *GetProjects) GetProjects() *GetProjects_Projects {
+func (t *GetNotifications_Notifications_Nodes_IssueNotification) GetIssue() *GetNotifications_Notifications_Nodes_IssueNotification_Issue {
 	if t == nil {
-		t = &GetProjects{}
+		t = &GetNotifications_Notifications_Nodes_IssueNotification{}
 	}
-	return &t.Projects
+	return &t.Issue
 }
 
-type GetAllUsers struct {
-	Users GetAllUsers_Users "json:\"users\" graphql:\"users\""
+type GetNotifications_Notifications_Nodes struct {
+	ID                string                                                 "json:\"id\" graphql:\"id\""
+	Type              string                                                 "json:\"type\" graphql:\"type\""
+	Title             string                                                 "json:\"title\" graphql:\"title\""
+	Subtitle          string                                                 "json:\"subtitle\" graphql:\"subtitle\""
+	ReadAt            *string                                                "json:\"readAt,omitempty\" graphql:\"readAt\""
+	SnoozedUntilAt    *string                                                "json:\"snoozedUntilAt,omitempty\" graphql:\"snoozedUntilAt\""
+	CreatedAt         string                                                 "json:\"createdAt\" graphql:\"createdAt\""
+	Actor             *GetNotifications_Notifications_Nodes_Actor            "json:\"actor,omitempty\" graphql:\"actor\""
+	IssueNotification GetNotifications_Notifications_Nodes_IssueNotification "graphql:\"... on IssueNotification\""
 }
 
-func (t *GetAllUsers) GetUsers() *GetAllUsers_Users {
+func (t *GetNotifications_Notifications_Nodes) GetID() string {
 	if t == nil {
-		t = &GetAllUsers{}
+		t = &GetNotifications_Notifications_Nodes{}
 	}
-	return &t.Users
+	return t.ID
+}
+func (t *GetNotifications_Notifications_Nodes) GetType() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.Type
+}
+func (t *GetNotifications_Notifications_Nodes) GetTitle() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.Title
+}
+func (t *GetNotifications_Notifications_Nodes) GetSubtitle() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.Subtitle
+}
+func (t *GetNotifications_Notifications_Nodes) GetReadAt() *string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.ReadAt
+}
+func (t *GetNotifications_Notifications_Nodes) GetSnoozedUntilAt() *string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.SnoozedUntilAt
+}
+func (t *GetNotifications_Notifications_Nodes) GetCreatedAt() string {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.CreatedAt
+}
+func (t *GetNotifications_Notifications_Nodes) GetActor() *GetNotifications_Notifications_Nodes_Actor {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return t.Actor
+}
+func (t *GetNotifications_Notifications_Nodes) GetIssueNotification() *GetNotifications_Notifications_Nodes_IssueNotification {
+	if t == nil {
+		t = &GetNotifications_Notifications_Nodes{}
+	}
+	return &t.IssueNotification
 }
 
-type GetMe struct {
-	Viewer GetMe_Viewer "json:\"viewer\" graphql:\"viewer\""
+type GetNotifications_Notifications_PageInfo struct {
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
 }
 
-func (t *GetMe) GetViewer() *GetMe_Viewer {
+func (t *GetNotifications_Notifications_PageInfo) GetHasNextPage() bool {
 	if t == nil {
-		t = &GetMe{}
+		t = &GetNotifications_Notifications_PageInfo{}
 	}
-	return &t.Viewer
+	return t.HasNextPage
+}
+func (t *GetNotifications_Notifications_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &GetNotifications_Notifications_PageInfo{}
+	}
+	return t.EndCursor
 }
 
-const GetIssuesDocument = `query GetIssues ($filter: IssueFilter, $after: String, $first: Int = 50) {
-	issues(filter: $filter, after: $after, first: $first) {
-		nodes {
-			id
-			identifier
-			title
-			priority
-			description
-			team {
-				id
-				name
-				color
-			}
-			assignee {
-				id
-				name
-				email
+type GetNotifications_Notifications struct {
+	Nodes    []*GetNotifications_Notifications_Nodes "json:\"nodes\" graphql:\"nodes\""
+	PageInfo GetNotifications_Notifications_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+}
+
+func (t *GetNotifications_Notifications) GetNodes() []*GetNotifications_Notifications_Nodes {
+	if t == nil {
+		t = &GetNotifications_Notifications{}
+	}
+	return t.Nodes
+}
+func (t *GetNotifications_Notifications) GetPageInfo() *GetNotifications_Notifications_PageInfo {
+	if t == nil {
+		t = &GetNotifications_Notifications{}
+	}
+	return &t.PageInfo
+}
+
+type UpdateNotification_NotificationUpdate struct {
+	Success bool "json:\"success\" graphql:\"success\""
+}
+
+func (t *UpdateNotification_NotificationUpdate) GetSuccess() bool {
+	if t == nil {
+		t = &UpdateNotification_NotificationUpdate{}
+	}
+	return t.Success
+}
+
+type GetCustomViews_CustomViews_Nodes_Team struct {
+	ID string "json:\"id\" graphql:\"id\""
+}
+
+func (t *GetCustomViews_CustomViews_Nodes_Team) GetID() string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes_Team{}
+	}
+	return t.ID
+}
+
+type GetCustomViews_CustomViews_Nodes struct {
+	ID          string                                 "json:\"id\" graphql:\"id\""
+	Name        string                                 "json:\"name\" graphql:\"name\""
+	Description *string                                "json:\"description,omitempty\" graphql:\"description\""
+	Color       *string                                "json:\"color,omitempty\" graphql:\"color\""
+	Shared      bool                                   "json:\"shared\" graphql:\"shared\""
+	FilterData  map[string]interface{}                 "json:\"filterData\" graphql:\"filterData\""
+	Team        *GetCustomViews_CustomViews_Nodes_Team "json:\"team,omitempty\" graphql:\"team\""
+}
+
+func (t *GetCustomViews_CustomViews_Nodes) GetID() string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.ID
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetName() string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.Name
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetDescription() *string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.Description
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetColor() *string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.Color
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetShared() bool {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.Shared
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetFilterData() map[string]interface{} {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.FilterData
+}
+func (t *GetCustomViews_CustomViews_Nodes) GetTeam() *GetCustomViews_CustomViews_Nodes_Team {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_Nodes{}
+	}
+	return t.Team
+}
+
+type GetCustomViews_CustomViews_PageInfo struct {
+	HasNextPage bool    "json:\"hasNextPage\" graphql:\"hasNextPage\""
+	EndCursor   *string "json:\"endCursor,omitempty\" graphql:\"endCursor\""
+}
+
+func (t *GetCustomViews_CustomViews_PageInfo) GetHasNextPage() bool {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_PageInfo{}
+	}
+	return t.HasNextPage
+}
+func (t *GetCustomViews_CustomViews_PageInfo) GetEndCursor() *string {
+	if t == nil {
+		t = &GetCustomViews_CustomViews_PageInfo{}
+	}
+	return t.EndCursor
+}
+
+type GetCustomViews_CustomViews struct {
+	Nodes    []*GetCustomViews_CustomViews_Nodes "json:\"nodes\" graphql:\"nodes\""
+	PageInfo GetCustomViews_CustomViews_PageInfo "json:\"pageInfo\" graphql:\"pageInfo\""
+}
+
+func (t *GetCustomViews_CustomViews) GetNodes() []*GetCustomViews_CustomViews_Nodes {
+	if t == nil {
+		t = &GetCustomViews_CustomViews{}
+	}
+	return t.Nodes
+}
+func (t *GetCustomViews_CustomViews) GetPageInfo() *GetCustomViews_CustomViews_PageInfo {
+	if t == nil {
+		t = &GetCustomViews_CustomViews{}
+	}
+	return &t.PageInfo
+}
+
+type ArchiveIssue_IssueArchive struct {
+	Success bool "json:\"success\" graphql:\"success\""
+}
+
+func (t *ArchiveIssue_IssueArchive) GetSuccess() bool {
+	if t == nil {
+		t = &ArchiveIssue_IssueArchive{}
+	}
+	return t.Success
+}
+
+type DeleteIssue_IssueDelete struct {
+	Success bool "json:\"success\" graphql:\"success\""
+}
+
+func (t *DeleteIssue_IssueDelete) GetSuccess() bool {
+	if t == nil {
+		t = &DeleteIssue_IssueDelete{}
+	}
+	return t.Success
+}
+
+type UnarchiveIssue_IssueUnarchive struct {
+	Success bool "json:\"success\" graphql:\"success\""
+}
+
+func (t *UnarchiveIssue_IssueUnarchive) GetSuccess() bool {
+	if t == nil {
+		t = &UnarchiveIssue_IssueUnarchive{}
+	}
+	return t.Success
+}
+
+type CreateIssue_IssueCreate_Issue struct {
+	ID         string "json:\"id\" graphql:\"id\""
+	Identifier string "json:\"identifier\" graphql:\"identifier\""
+}
+
+func (t *CreateIssue_IssueCreate_Issue) GetID() string {
+	if t == nil {
+		t = &CreateIssue_IssueCreate_Issue{}
+	}
+	return t.ID
+}
+func (t *CreateIssue_IssueCreate_Issue) GetIdentifier() string {
+	if t == nil {
+		t = &CreateIssue_IssueCreate_Issue{}
+	}
+	return t.Identifier
+}
+
+type CreateIssue_IssueCreate struct {
+	Success bool                           "json:\"success\" graphql:\"success\""
+	Issue   *CreateIssue_IssueCreate_Issue "json:\"issue,omitempty\" graphql:\"issue\""
+}
+
+func (t *CreateIssue_IssueCreate) GetSuccess() bool {
+	if t == nil {
+		t = &CreateIssue_IssueCreate{}
+	}
+	return t.Success
+}
+func (t *CreateIssue_IssueCreate) GetIssue() *CreateIssue_IssueCreate_Issue {
+	if t == nil {
+		t = &CreateIssue_IssueCreate{}
+	}
+	return t.Issue
+}
+
+type GetIssueActivity_Issue_Comments_Nodes_User struct {
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetIssueActivity_Issue_Comments_Nodes_User) GetDisplayName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments_Nodes_User{}
+	}
+	return t.DisplayName
+}
+
+type GetIssueActivity_Issue_Comments_Nodes struct {
+	ID        string                                      "json:\"id\" graphql:\"id\""
+	Body      string                                      "json:\"body\" graphql:\"body\""
+	CreatedAt string                                      "json:\"createdAt\" graphql:\"createdAt\""
+	User      *GetIssueActivity_Issue_Comments_Nodes_User "json:\"user,omitempty\" graphql:\"user\""
+}
+
+func (t *GetIssueActivity_Issue_Comments_Nodes) GetID() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments_Nodes{}
+	}
+	return t.ID
+}
+func (t *GetIssueActivity_Issue_Comments_Nodes) GetBody() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments_Nodes{}
+	}
+	return t.Body
+}
+func (t *GetIssueActivity_Issue_Comments_Nodes) GetCreatedAt() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments_Nodes{}
+	}
+	return t.CreatedAt
+}
+func (t *GetIssueActivity_Issue_Comments_Nodes) GetUser() *GetIssueActivity_Issue_Comments_Nodes_User {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments_Nodes{}
+	}
+	return t.User
+}
+
+type GetIssueActivity_Issue_Comments struct {
+	Nodes []*GetIssueActivity_Issue_Comments_Nodes "json:\"nodes\" graphql:\"nodes\""
+}
+
+func (t *GetIssueActivity_Issue_Comments) GetNodes() []*GetIssueActivity_Issue_Comments_Nodes {
+	if t == nil {
+		t = &GetIssueActivity_Issue_Comments{}
+	}
+	return t.Nodes
+}
+
+type GetIssueActivity_Issue_History_Nodes_Actor struct {
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_Actor) GetDisplayName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_Actor{}
+	}
+	return t.DisplayName
+}
+
+type GetIssueActivity_Issue_History_Nodes_FromState struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_FromState) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_FromState{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes_ToState struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_ToState) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_ToState{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes_FromAssignee struct {
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_FromAssignee) GetDisplayName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_FromAssignee{}
+	}
+	return t.DisplayName
+}
+
+type GetIssueActivity_Issue_History_Nodes_ToAssignee struct {
+	DisplayName string "json:\"displayName\" graphql:\"displayName\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_ToAssignee) GetDisplayName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_ToAssignee{}
+	}
+	return t.DisplayName
+}
+
+type GetIssueActivity_Issue_History_Nodes_FromProject struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_FromProject) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_FromProject{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes_ToProject struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_ToProject) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_ToProject{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes_AddedLabels struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_AddedLabels) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_AddedLabels{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes_RemovedLabels struct {
+	Name string "json:\"name\" graphql:\"name\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes_RemovedLabels) GetName() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes_RemovedLabels{}
+	}
+	return t.Name
+}
+
+type GetIssueActivity_Issue_History_Nodes struct {
+	ID            string                                                "json:\"id\" graphql:\"id\""
+	CreatedAt     string                                                "json:\"createdAt\" graphql:\"createdAt\""
+	Actor         *GetIssueActivity_Issue_History_Nodes_Actor           "json:\"actor,omitempty\" graphql:\"actor\""
+	FromState     *GetIssueActivity_Issue_History_Nodes_FromState       "json:\"fromState,omitempty\" graphql:\"fromState\""
+	ToState       *GetIssueActivity_Issue_History_Nodes_ToState         "json:\"toState,omitempty\" graphql:\"toState\""
+	FromAssignee  *GetIssueActivity_Issue_History_Nodes_FromAssignee    "json:\"fromAssignee,omitempty\" graphql:\"fromAssignee\""
+	ToAssignee    *GetIssueActivity_Issue_History_Nodes_ToAssignee      "json:\"toAssignee,omitempty\" graphql:\"toAssignee\""
+	FromPriority  *float64                                              "json:\"fromPriority,omitempty\" graphql:\"fromPriority\""
+	ToPriority    *float64                                              "json:\"toPriority,omitempty\" graphql:\"toPriority\""
+	FromProject   *GetIssueActivity_Issue_History_Nodes_FromProject     "json:\"fromProject,omitempty\" graphql:\"fromProject\""
+	ToProject     *GetIssueActivity_Issue_History_Nodes_ToProject       "json:\"toProject,omitempty\" graphql:\"toProject\""
+	ToTitle       *string                                               "json:\"toTitle,omitempty\" graphql:\"toTitle\""
+	AddedLabels   []*GetIssueActivity_Issue_History_Nodes_AddedLabels   "json:\"addedLabels,omitempty\" graphql:\"addedLabels\""
+	RemovedLabels []*GetIssueActivity_Issue_History_Nodes_RemovedLabels "json:\"removedLabels,omitempty\" graphql:\"removedLabels\""
+}
+
+func (t *GetIssueActivity_Issue_History_Nodes) GetID() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ID
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetCreatedAt() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.CreatedAt
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetActor() *GetIssueActivity_Issue_History_Nodes_Actor {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.Actor
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetFromState() *GetIssueActivity_Issue_History_Nodes_FromState {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.FromState
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetToState() *GetIssueActivity_Issue_History_Nodes_ToState {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ToState
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetFromAssignee() *GetIssueActivity_Issue_History_Nodes_FromAssignee {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.FromAssignee
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetToAssignee() *GetIssueActivity_Issue_History_Nodes_ToAssignee {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ToAssignee
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetFromPriority() *float64 {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.FromPriority
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetToPriority() *float64 {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ToPriority
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetFromProject() *GetIssueActivity_Issue_History_Nodes_FromProject {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.FromProject
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetToProject() *GetIssueActivity_Issue_History_Nodes_ToProject {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ToProject
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetToTitle() *string {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.ToTitle
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetAddedLabels() []*GetIssueActivity_Issue_History_Nodes_AddedLabels {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.AddedLabels
+}
+func (t *GetIssueActivity_Issue_History_Nodes) GetRemovedLabels() []*GetIssueActivity_Issue_History_Nodes_RemovedLabels {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History_Nodes{}
+	}
+	return t.RemovedLabels
+}
+
+type GetIssueActivity_Issue_History struct {
+	Nodes []*GetIssueActivity_Issue_History_Nodes "json:\"nodes\" graphql:\"nodes\""
+}
+
+func (t *GetIssueActivity_Issue_History) GetNodes() []*GetIssueActivity_Issue_History_Nodes {
+	if t == nil {
+		t = &GetIssueActivity_Issue_History{}
+	}
+	return t.Nodes
+}
+
+type GetIssueActivity_Issue struct {
+	ID       string                          "json:\"id\" graphql:\"id\""
+	Comments GetIssueActivity_Issue_Comments "json:\"comments\" graphql:\"comments\""
+	History  GetIssueActivity_Issue_History  "json:\"history\" graphql:\"history\""
+}
+
+func (t *GetIssueActivity_Issue) GetID() string {
+	if t == nil {
+		t = &GetIssueActivity_Issue{}
+	}
+	return t.ID
+}
+func (t *GetIssueActivity_Issue) GetComments() *GetIssueActivity_Issue_Comments {
+	if t == nil {
+		t = &GetIssueActivity_Issue{}
+	}
+	return &t.Comments
+}
+func (t *GetIssueActivity_Issue) GetHistory() *GetIssueActivity_Issue_History {
+	if t == nil {
+		t = &GetIssueActivity_Issue{}
+	}
+	return &t.History
+}
+
+type GetIssues struct {
+	Issues GetIssues_Issues "json:\"issues\" graphql:\"issues\""
+}
+
+func (t *GetIssues) GetIssues() *GetIssues_Issues {
+	if t == nil {
+		t = &GetIssues{}
+	}
+	return &t.Issues
+}
+
+type BatchUpdateIssues struct {
+	IssueBatchUpdate BatchUpdateIssues_IssueBatchUpdate "json:\"issueBatchUpdate\" graphql:\"issueBatchUpdate\""
+}
+
+func (t *BatchUpdateIssues) GetIssueBatchUpdate() *BatchUpdateIssues_IssueBatchUpdate {
+	if t == nil {
+		t = &BatchUpdateIssues{}
+	}
+	return &t.IssueBatchUpdate
+}
+
+type GetProjects struct {
+	Projects GetProjects_Projects "json:\"projects\" graphql:\"projects\""
+}
+
+func (t *GetProjects) GetProjects() *GetProjects_Projects {
+	if t == nil {
+		t = &GetProjects{}
+	}
+	return &t.Projects
+}
+
+type GetAllUsers struct {
+	Users GetAllUsers_Users "json:\"users\" graphql:\"users\""
+}
+
+func (t *GetAllUsers) GetUsers() *GetAllUsers_Users {
+	if t == nil {
+		t = &GetAllUsers{}
+	}
+	return &t.Users
+}
+
+type GetMe struct {
+	Viewer GetMe_Viewer "json:\"viewer\" graphql:\"viewer\""
+}
+
+func (t *GetMe) GetViewer() *GetMe_Viewer {
+	if t == nil {
+		t = &GetMe{}
+	}
+	return &t.Viewer
+}
+
+type GetNotifications struct {
+	Notifications GetNotifications_Notifications "json:\"notifications\" graphql:\"notifications\""
+}
+
+func (t *GetNotifications) GetNotifications() *GetNotifications_Notifications {
+	if t == nil {
+		t = &GetNotifications{}
+	}
+	return &t.Notifications
+}
+
+type UpdateNotification struct {
+	NotificationUpdate UpdateNotification_NotificationUpdate "json:\"notificationUpdate\" graphql:\"notificationUpdate\""
+}
+
+func (t *UpdateNotification) GetNotificationUpdate() *UpdateNotification_NotificationUpdate {
+	if t == nil {
+		t = &UpdateNotification{}
+	}
+	return &t.NotificationUpdate
+}
+
+type GetCustomViews struct {
+	CustomViews GetCustomViews_CustomViews "json:\"customViews\" graphql:\"customViews\""
+}
+
+func (t *GetCustomViews) GetCustomViews() *GetCustomViews_CustomViews {
+	if t == nil {
+		t = &GetCustomViews{}
+	}
+	return &t.CustomViews
+}
+
+type ArchiveIssue struct {
+	IssueArchive ArchiveIssue_IssueArchive "json:\"issueArchive\" graphql:\"issueArchive\""
+}
+
+func (t *ArchiveIssue) GetIssueArchive() *ArchiveIssue_IssueArchive {
+	if t == nil {
+		t = &ArchiveIssue{}
+	}
+	return &t.IssueArchive
+}
+
+type DeleteIssue struct {
+	IssueDelete DeleteIssue_IssueDelete "json:\"issueDelete\" graphql:\"issueDelete\""
+}
+
+func (t *DeleteIssue) GetIssueDelete() *DeleteIssue_IssueDelete {
+	if t == nil {
+		t = &DeleteIssue{}
+	}
+	return &t.IssueDelete
+}
+
+type UnarchiveIssue struct {
+	IssueUnarchive UnarchiveIssue_IssueUnarchive "json:\"issueUnarchive\" graphql:\"issueUnarchive\""
+}
+
+func (t *UnarchiveIssue) GetIssueUnarchive() *UnarchiveIssue_IssueUnarchive {
+	if t == nil {
+		t = &UnarchiveIssue{}
+	}
+	return &t.IssueUnarchive
+}
+
+type CreateIssue struct {
+	IssueCreate CreateIssue_IssueCreate "json:\"issueCreate\" graphql:\"issueCreate\""
+}
+
+func (t *CreateIssue) GetIssueCreate() *CreateIssue_IssueCreate {
+	if t == nil {
+		t = &CreateIssue{}
+	}
+	return &t.IssueCreate
+}
+
+type GetIssueActivity struct {
+	Issue GetIssueActivity_Issue "json:\"issue\" graphql:\"issue\""
+}
+
+func (t *GetIssueActivity) GetIssue() *GetIssueActivity_Issue {
+	if t == nil {
+		t = &GetIssueActivity{}
+	}
+	return &t.Issue
+}
+
+const GetIssuesDocument = `query GetIssues ($filter: IssueFilter, $after: String, $first: Int = 50) {
+	issues(filter: $filter, after: $after, first: $first) {
+		nodes {
+			id
+			identifier
+			title
+			branchName
+			priority
+			description
+			team {
+				id
+				name
+				color
+			}
+			assignee {
+				id
+				name
+				email
 				displayName
 				isMe
 			}
@@ -838,11 +1825,16 @@ const GetIssuesDocument = `query GetIssues ($filter: IssueFilter, $after: String
 				name
 				color
 			}
+			projectMilestone {
+				id
+				name
+			}
 			state {
 				id
 				name
 				color
 				position
+				type
 				team {
 					id
 				}
@@ -860,6 +1852,20 @@ const GetIssuesDocument = `query GetIssues ($filter: IssueFilter, $after: String
 			createdAt
 			updatedAt
 			canceledAt
+			estimate
+			dueDate
+			cycle {
+				id
+				number
+				name
+			}
+			creator {
+				id
+				name
+				email
+				displayName
+				isMe
+			}
 		}
 		pageInfo {
 			hasNextPage
@@ -919,6 +1925,16 @@ const GetProjectsDocument = `query GetProjects ($after: String, $first: Int = 50
 			name
 			id
 			color
+			description
+			state
+			startDate
+			targetDate
+			progress
+			health
+			lead {
+				id
+				displayName
+			}
 			teams {
 				nodes {
 					id
@@ -926,6 +1942,14 @@ const GetProjectsDocument = `query GetProjects ($after: String, $first: Int = 50
 					color
 				}
 			}
+			projectMilestones {
+				nodes {
+					id
+					name
+					targetDate
+					sortOrder
+				}
+			}
 		}
 		pageInfo {
 			endCursor
@@ -1009,6 +2033,8 @@ const GetMeDocument = `query GetMe {
 						id
 						name
 						color
+						position
+						type
 					}
 				}
 				labels {
@@ -1043,10 +2069,300 @@ func (c *Client) GetMe(ctx context.Context, interceptors ...clientv2.RequestInte
 	return &res, nil
 }
 
+const GetNotificationsDocument = `query GetNotifications ($after: String, $first: Int = 50) {
+	notifications(after: $after, first: $first) {
+		nodes {
+			id
+			type
+			title
+			subtitle
+			readAt
+			snoozedUntilAt
+			createdAt
+			actor {
+				id
+				name
+				displayName
+			}
+			... on IssueNotification {
+				issue {
+					id
+					identifier
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+func (c *Client) GetNotifications(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetNotifications, error) {
+	vars := map[string]any{
+		"after": after,
+		"first": first,
+	}
+
+	var res GetNotifications
+	if err := c.Client.Post(ctx, "GetNotifications", GetNotificationsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UpdateNotificationDocument = `mutation UpdateNotification ($id: String!, $input: NotificationUpdateInput!) {
+	notificationUpdate(id: $id, input: $input) {
+		success
+	}
+}
+`
+
+func (c *Client) UpdateNotification(ctx context.Context, id string, input models.NotificationUpdateInput, interceptors ...clientv2.RequestInterceptor) (*UpdateNotification, error) {
+	vars := map[string]any{
+		"id":    id,
+		"input": input,
+	}
+
+	var res UpdateNotification
+	if err := c.Client.Post(ctx, "UpdateNotification", UpdateNotificationDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetCustomViewsDocument = `query GetCustomViews ($after: String, $first: Int = 50) {
+	customViews(after: $after, first: $first) {
+		nodes {
+			id
+			name
+			description
+			color
+			shared
+			filterData
+			team {
+				id
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+func (c *Client) GetCustomViews(ctx context.Context, after *string, first *int64, interceptors ...clientv2.RequestInterceptor) (*GetCustomViews, error) {
+	vars := map[string]any{
+		"after": after,
+		"first": first,
+	}
+
+	var res GetCustomViews
+	if err := c.Client.Post(ctx, "GetCustomViews", GetCustomViewsDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const ArchiveIssueDocument = `mutation ArchiveIssue ($id: String!) {
+	issueArchive(id: $id) {
+		success
+	}
+}
+`
+
+func (c *Client) ArchiveIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*ArchiveIssue, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res ArchiveIssue
+	if err := c.Client.Post(ctx, "ArchiveIssue", ArchiveIssueDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const DeleteIssueDocument = `mutation DeleteIssue ($id: String!) {
+	issueDelete(id: $id) {
+		success
+	}
+}
+`
+
+func (c *Client) DeleteIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*DeleteIssue, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res DeleteIssue
+	if err := c.Client.Post(ctx, "DeleteIssue", DeleteIssueDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const UnarchiveIssueDocument = `mutation UnarchiveIssue ($id: String!) {
+	issueUnarchive(id: $id) {
+		success
+	}
+}
+`
+
+func (c *Client) UnarchiveIssue(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*UnarchiveIssue, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res UnarchiveIssue
+	if err := c.Client.Post(ctx, "UnarchiveIssue", UnarchiveIssueDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const CreateIssueDocument = `mutation CreateIssue ($input: IssueCreateInput!) {
+	issueCreate(input: $input) {
+		success
+		issue {
+			id
+			identifier
+		}
+	}
+}
+`
+
+func (c *Client) CreateIssue(ctx context.Context, input models.IssueCreateInput, interceptors ...clientv2.RequestInterceptor) (*CreateIssue, error) {
+	vars := map[string]any{
+		"input": input,
+	}
+
+	var res CreateIssue
+	if err := c.Client.Post(ctx, "CreateIssue", CreateIssueDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+const GetIssueActivityDocument = `query GetIssueActivity ($id: String!) {
+	issue(id: $id) {
+		id
+		comments(first: 50) {
+			nodes {
+				id
+				body
+				createdAt
+				user {
+					displayName
+				}
+			}
+		}
+		history(first: 50) {
+			nodes {
+				id
+				createdAt
+				actor {
+					displayName
+				}
+				fromState {
+					name
+				}
+				toState {
+					name
+				}
+				fromAssignee {
+					displayName
+				}
+				toAssignee {
+					displayName
+				}
+				fromPriority
+				toPriority
+				fromProject {
+					name
+				}
+				toProject {
+					name
+				}
+				toTitle
+				addedLabels {
+					name
+				}
+				removedLabels {
+					name
+				}
+			}
+		}
+	}
+}
+`
+
+func (c *Client) GetIssueActivity(ctx context.Context, id string, interceptors ...clientv2.RequestInterceptor) (*GetIssueActivity, error) {
+	vars := map[string]any{
+		"id": id,
+	}
+
+	var res GetIssueActivity
+	if err := c.Client.Post(ctx, "GetIssueActivity", GetIssueActivityDocument, &res, vars, interceptors...); err != nil {
+		if c.Client.ParseDataWhenErrors {
+			return &res, err
+		}
+
+		return nil, err
+	}
+
+	return &res, nil
+}
+
 var DocumentOperationNames = map[string]string{
-	GetIssuesDocument:         "GetIssues",
-	BatchUpdateIssuesDocument: "BatchUpdateIssues",
-	GetProjectsDocument:       "GetProjects",
-	GetAllUsersDocument:       "GetAllUsers",
-	GetMeDocument:             "GetMe",
+	GetIssuesDocument:          "GetIssues",
+	BatchUpdateIssuesDocument:  "BatchUpdateIssues",
+	GetProjectsDocument:        "GetProjects",
+	GetAllUsersDocument:        "GetAllUsers",
+	GetMeDocument:              "GetMe",
+	GetNotificationsDocument:   "GetNotifications",
+	UpdateNotificationDocument: "UpdateNotification",
+	GetCustomViewsDocument:     "GetCustomViews",
+	ArchiveIssueDocument:       "ArchiveIssue",
+	DeleteIssueDocument:        "DeleteIssue",
+	UnarchiveIssueDocument:     "UnarchiveIssue",
+	CreateIssueDocument:        "CreateIssue",
+	GetIssueActivityDocument:   "GetIssueActivity",
 }
@@ -2,6 +2,7 @@ package linearClient
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/Yamashou/gqlgenc/clientv2"
@@ -24,3 +25,32 @@ func GetAuthMiddleware(apiKey string) clientv2.RequestInterceptor {
 		return nil
 	}
 }
+
+type TokenSource interface {
+	AccessToken(ctx context.Context) (string, error)
+}
+
+// GetTokenMiddleware authorizes with an oauth access token, the source is
+// asked on every request so expired tokens are refreshed before being sent.
+func GetTokenMiddleware(source TokenSource) clientv2.RequestInterceptor {
+	return func(
+		ctx context.Context,
+		req *http.Request,
+		gqlInfo *clientv2.GQLRequestInfo,
+		res interface{},
+		next clientv2.RequestInterceptorFunc,
+	) error {
+		token, err := source.AccessToken(ctx)
+		if err != nil {
+			return fmt.Errorf("couldn't get access token: %w", err)
+		}
+
+		req.Header.Set("Authorization", "Bearer "+token)
+
+		if next != nil {
+			return next(ctx, req, gqlInfo, res)
+		}
+
+		return nil
+	}
+}
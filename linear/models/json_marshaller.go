@@ -9,8 +9,15 @@ import (
 const NullString string = "__NULL__"
 
 func (i IssueUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalWithNulls(reflect.ValueOf(&i).Elem())
+}
+
+func (i NotificationUpdateInput) MarshalJSON() ([]byte, error) {
+	return marshalWithNulls(reflect.ValueOf(&i).Elem())
+}
+
+func marshalWithNulls(val reflect.Value) ([]byte, error) {
 	result := make(map[string]interface{})
-	val := reflect.ValueOf(&i).Elem()
 	typ := val.Type()
 
 	for i := 0; i < val.NumField(); i++ {
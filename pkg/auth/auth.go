@@ -0,0 +1,230 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+)
+
+var ErrNoRefreshToken = errors.New("token can't be refreshed")
+
+type Token struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type"`
+	Expiry       time.Time `json:"expiry,omitempty"`
+}
+
+// Expired reports tokens that run out within the next minute, so a request
+// started with it doesn't fail halfway.
+func (t Token) Expired() bool {
+	return !t.Expiry.IsZero() && time.Until(t.Expiry) < time.Minute
+}
+
+type tokenResponse struct {
+	AccessToken      string `json:"access_token"`
+	RefreshToken     string `json:"refresh_token"`
+	TokenType        string `json:"token_type"`
+	ExpiresIn        int64  `json:"expires_in"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+func randomString(n int) (string, error) {
+	b := make([]byte, n)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", fmt.Errorf("couldn't generate random string: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+type callbackResult struct {
+	code string
+	err  error
+}
+
+// Login runs the authorization code flow with PKCE, open is handed the url
+// the user has to visit and the code comes back through a loopback server.
+func Login(ctx context.Context, cfg config.OAuth, open func(url string) error) (Token, error) {
+	if cfg.ClientID == "" {
+		return Token{}, errors.New("oauth client_id is not configured")
+	}
+
+	verifier, err := randomString(32)
+	if err != nil {
+		return Token{}, err
+	}
+
+	state, err := randomString(16)
+	if err != nil {
+		return Token{}, err
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", cfg.CallbackPort))
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't start callback server: %w", err)
+	}
+
+	redirectURI := fmt.Sprintf("http://%s/callback", listener.Addr().String())
+
+	results := make(chan callbackResult, 1)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		var res callbackResult
+		switch {
+		case query.Get("state") != state:
+			res.err = errors.New("state of the callback doesn't match")
+		case query.Get("error") != "":
+			res.err = fmt.Errorf("authorization denied: %s", query.Get("error"))
+		case query.Get("code") == "":
+			res.err = errors.New("callback has no code")
+		default:
+			res.code = query.Get("code")
+		}
+
+		if res.err != nil {
+			http.Error(w, res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "tinear is logged in, you can close this tab now.")
+		}
+
+		select {
+		case results <- res:
+		default:
+		}
+	})
+
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	defer server.Close()
+
+	authorizeURL, err := url.Parse(cfg.AuthorizeURL)
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't parse authorize url: %w", err)
+	}
+
+	params := authorizeURL.Query()
+	params.Set("response_type", "code")
+	params.Set("client_id", cfg.ClientID)
+	params.Set("redirect_uri", redirectURI)
+	params.Set("scope", strings.Join(cfg.Scopes, ","))
+	params.Set("state", state)
+	params.Set("code_challenge", codeChallenge(verifier))
+	params.Set("code_challenge_method", "S256")
+	authorizeURL.RawQuery = params.Encode()
+
+	err = open(authorizeURL.String())
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't open authorize url: %w", err)
+	}
+
+	var res callbackResult
+	select {
+	case <-ctx.Done():
+		return Token{}, ctx.Err()
+	case res = <-results:
+	}
+
+	if res.err != nil {
+		return Token{}, res.err
+	}
+
+	return requestToken(ctx, cfg, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {res.code},
+		"redirect_uri":  {redirectURI},
+		"code_verifier": {verifier},
+	})
+}
+
+func Refresh(ctx context.Context, cfg config.OAuth, token Token) (Token, error) {
+	if token.RefreshToken == "" {
+		return Token{}, ErrNoRefreshToken
+	}
+
+	refreshed, err := requestToken(ctx, cfg, url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {token.RefreshToken},
+	})
+	if err != nil {
+		return Token{}, err
+	}
+
+	// NOTE: the refresh token is only sent again when it was rotated
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+
+	return refreshed, nil
+}
+
+func requestToken(ctx context.Context, cfg config.OAuth, form url.Values) (Token, error) {
+	form.Set("client_id", cfg.ClientID)
+	if cfg.ClientSecret != "" {
+		form.Set("client_secret", cfg.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't create token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return Token{}, fmt.Errorf("token request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't read token response: %w", err)
+	}
+
+	var tokenResp tokenResponse
+	err = json.Unmarshal(body, &tokenResp)
+	if err != nil {
+		return Token{}, fmt.Errorf("couldn't decode token response (status %d): %w", resp.StatusCode, err)
+	}
+
+	if tokenResp.Error != "" {
+		return Token{}, fmt.Errorf("token request rejected: %s %s", tokenResp.Error, tokenResp.ErrorDescription)
+	}
+
+	if resp.StatusCode != http.StatusOK || tokenResp.AccessToken == "" {
+		return Token{}, fmt.Errorf("token request failed with status %d", resp.StatusCode)
+	}
+
+	token := Token{
+		AccessToken:  tokenResp.AccessToken,
+		RefreshToken: tokenResp.RefreshToken,
+		TokenType:    tokenResp.TokenType,
+	}
+	if tokenResp.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
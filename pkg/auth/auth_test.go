@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+)
+
+// tokenServer stands in for the token endpoint of linear, handing out tokens
+// for the code it expects.
+func tokenServer(t *testing.T, code string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := r.ParseForm()
+		if err != nil {
+			t.Errorf("couldn't parse token request: %v", err)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		switch r.PostForm.Get("grant_type") {
+		case "authorization_code":
+			if r.PostForm.Get("code") != code || r.PostForm.Get("code_verifier") == "" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token": "access", "refresh_token": "refresh", "token_type": "Bearer", "expires_in": 3600}`)
+		case "refresh_token":
+			if r.PostForm.Get("refresh_token") != "refresh" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"error": "invalid_grant"}`)
+				return
+			}
+			fmt.Fprint(w, `{"access_token": "refreshed", "token_type": "Bearer", "expires_in": 3600}`)
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "unsupported_grant_type"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+// authorize plays the browser, sending the code back to the redirect uri of
+// the authorize url as linear does once the user allows access.
+func authorize(code string) func(string) error {
+	return func(authorizeURL string) error {
+		u, err := url.Parse(authorizeURL)
+		if err != nil {
+			return err
+		}
+
+		params := u.Query()
+		if params.Get("code_challenge_method") != "S256" || params.Get("code_challenge") == "" {
+			return fmt.Errorf("authorize url has no code challenge: %s", authorizeURL)
+		}
+
+		callback, err := url.Parse(params.Get("redirect_uri"))
+		if err != nil {
+			return err
+		}
+		callback.RawQuery = url.Values{
+			"code":  {code},
+			"state": {params.Get("state")},
+		}.Encode()
+
+		// NOTE: Login waits on the callback after open returns
+		go func() {
+			resp, err := http.Get(callback.String())
+			if err == nil {
+				resp.Body.Close()
+			}
+		}()
+
+		return nil
+	}
+}
+
+func checkPerm(t *testing.T, path string) {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if runtime.GOOS != "windows" && info.Mode().Perm() != 0o600 {
+		t.Fatalf("expected credentials to be 0600, got %o", info.Mode().Perm())
+	}
+}
+
+func TestLogin(t *testing.T) {
+	server := tokenServer(t, "code")
+
+	cfg := config.OAuth{
+		ClientID:     "client",
+		AuthorizeURL: "https://linear.example/oauth/authorize",
+		TokenURL:     server.URL,
+		Scopes:       []string{"read", "write"},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	token, err := Login(ctx, cfg, authorize("code"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token.AccessToken != "access" || token.RefreshToken != "refresh" {
+		t.Fatalf("unexpected token %+v", token)
+	}
+	if token.Expired() {
+		t.Fatalf("expected the token to be valid, expires at %s", token.Expiry)
+	}
+
+	path := filepath.Join(t.TempDir(), "tinear", "credentials.json")
+
+	err = SaveCredentials(path, Credentials{"work": token})
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkPerm(t, path)
+
+	creds, err := LoadCredentials(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if creds["work"].AccessToken != "access" {
+		t.Fatalf("unexpected credentials %+v", creds)
+	}
+}
+
+func TestLoginRejected(t *testing.T) {
+	server := tokenServer(t, "code")
+
+	cfg := config.OAuth{
+		ClientID:     "client",
+		AuthorizeURL: "https://linear.example/oauth/authorize",
+		TokenURL:     server.URL,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err := Login(ctx, cfg, authorize("stolen"))
+	if err == nil {
+		t.Fatal("expected a code the token endpoint doesn't know to be rejected")
+	}
+}
+
+func TestSourceRefresh(t *testing.T) {
+	server := tokenServer(t, "code")
+
+	path := filepath.Join(t.TempDir(), "credentials.json")
+
+	err := SaveCredentials(path, Credentials{"work": {
+		AccessToken:  "access",
+		RefreshToken: "refresh",
+		Expiry:       time.Now().Add(-time.Hour),
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := NewSource(path, config.Workspace{
+		Name:  "work",
+		OAuth: config.OAuth{ClientID: "client", TokenURL: server.URL},
+	})
+
+	token, err := source.AccessToken(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "refreshed" {
+		t.Fatalf("expected the refreshed token, got %q", token)
+	}
+	checkPerm(t, path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var creds Credentials
+	err = json.Unmarshal(data, &creds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the refresh token isn't rotated, so the one we had is kept
+	if creds["work"].AccessToken != "refreshed" || creds["work"].RefreshToken != "refresh" {
+		t.Fatalf("expected the refreshed token to be saved, got %+v", creds["work"])
+	}
+}
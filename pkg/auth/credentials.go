@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+)
+
+var ErrNotLoggedIn = errors.New("not logged in, run `tinear login`")
+
+// Credentials are the tokens of every workspace by its name.
+type Credentials map[string]Token
+
+func LoadCredentials(path string) (Credentials, error) {
+	info, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Credentials{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("couldn't stat credentials: %w", err)
+	}
+
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		return nil, fmt.Errorf("credentials file %s is accessible by other users, restrict it with chmod 600", path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't read credentials: %w", err)
+	}
+
+	creds := Credentials{}
+	err = json.Unmarshal(data, &creds)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse credentials: %w", err)
+	}
+
+	return creds, nil
+}
+
+func SaveCredentials(path string, creds Credentials) error {
+	err := os.MkdirAll(filepath.Dir(path), 0o700)
+	if err != nil {
+		return fmt.Errorf("couldn't create credentials dir: %w", err)
+	}
+
+	data, err := json.MarshalIndent(creds, "", "  ")
+	if err != nil {
+		return fmt.Errorf("couldn't encode credentials: %w", err)
+	}
+
+	// write next to it first so a crash never leaves half a file behind
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".credentials-*")
+	if err != nil {
+		return fmt.Errorf("couldn't create credentials file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	err = tmp.Chmod(0o600)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("couldn't restrict credentials file: %w", err)
+	}
+
+	_, err = tmp.Write(data)
+	if err != nil {
+		tmp.Close()
+		return fmt.Errorf("couldn't write credentials: %w", err)
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return fmt.Errorf("couldn't close credentials file: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("couldn't replace credentials: %w", err)
+	}
+
+	return nil
+}
+
+// Source hands out the access token of a workspace, refreshing and
+// persisting it once it expires.
+type Source struct {
+	mu        sync.Mutex
+	path      string
+	workspace string
+	cfg       config.OAuth
+}
+
+func NewSource(path string, workspace config.Workspace) *Source {
+	return &Source{
+		path:      path,
+		workspace: workspace.Name,
+		cfg:       workspace.OAuth,
+	}
+}
+
+func (s *Source) AccessToken(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// NOTE: read every time since `tinear login` may have run in the meantime
+	creds, err := LoadCredentials(s.path)
+	if err != nil {
+		return "", err
+	}
+
+	token, ok := creds[s.workspace]
+	if !ok {
+		return "", ErrNotLoggedIn
+	}
+
+	if !token.Expired() {
+		return token.AccessToken, nil
+	}
+
+	token, err = Refresh(ctx, s.cfg, token)
+	if errors.Is(err, ErrNoRefreshToken) {
+		return "", ErrNotLoggedIn
+	}
+	if err != nil {
+		return "", fmt.Errorf("couldn't refresh token: %w", err)
+	}
+
+	creds[s.workspace] = token
+
+	err = SaveCredentials(s.path, creds)
+	if err != nil {
+		return "", err
+	}
+
+	return token.AccessToken, nil
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// eachIssue runs a mutation linear only offers for a single issue on every
+// issue, the response fails if any of them did.
+func (c *Client) eachIssue(issueIDs []string, onFail tea.Cmd, mutate func(id string) (bool, error)) tea.Cmd {
+	return c.command(func() tea.Msg {
+		response := UpdateIssuesResponse{
+			Success:       true,
+			OnFailCommand: onFail,
+		}
+
+		for _, id := range issueIDs {
+			success, err := mutate(id)
+			if err != nil {
+				return err
+			}
+			if !success {
+				response.Success = false
+			}
+		}
+
+		return response
+	})
+}
+
+func (c *Client) ArchiveIssues(issueIDs []string, onFail tea.Cmd) tea.Cmd {
+	return c.eachIssue(issueIDs, onFail, func(id string) (bool, error) {
+		resp, err := c.client.ArchiveIssue(c.ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return resp.GetIssueArchive().GetSuccess(), nil
+	})
+}
+
+// DeleteIssues moves the issues to linear's trash, from where they can still
+// be restored with UnarchiveIssues.
+func (c *Client) DeleteIssues(issueIDs []string, onFail tea.Cmd) tea.Cmd {
+	return c.eachIssue(issueIDs, onFail, func(id string) (bool, error) {
+		resp, err := c.client.DeleteIssue(c.ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return resp.GetIssueDelete().GetSuccess(), nil
+	})
+}
+
+func (c *Client) UnarchiveIssues(issueIDs []string, onFail tea.Cmd) tea.Cmd {
+	return c.eachIssue(issueIDs, onFail, func(id string) (bool, error) {
+		resp, err := c.client.UnarchiveIssue(c.ctx, id)
+		if err != nil {
+			return false, err
+		}
+		return resp.GetIssueUnarchive().GetSuccess(), nil
+	})
+}
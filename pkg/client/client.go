@@ -6,12 +6,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"log"
 	"net/http"
-	"os"
 
 	"github.com/Yamashou/gqlgenc/clientv2"
+	tea "github.com/charmbracelet/bubbletea"
 	linearClient "github.com/sayedmurtaza24/tinear/linear"
+	"github.com/sayedmurtaza24/tinear/pkg/auth"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
 )
 
 const linearBaseUrL = "https://api.linear.app/graphql"
@@ -19,27 +20,33 @@ const linearBaseUrL = "https://api.linear.app/graphql"
 type Client struct {
 	client    linearClient.LinearClient
 	rawClient *clientv2.Client
+	workspace string
+	ctx       context.Context
+	cancel    context.CancelFunc
 }
 
-func getApiKey() string {
-	apiKey := os.Getenv("LINEAR_API_KEY")
-	if apiKey == "" {
-		log.Fatal("LINEAR_API_KEY is not set")
+func authMiddleware(workspace config.Workspace) clientv2.RequestInterceptor {
+	if workspace.APIKey != "" {
+		return linearClient.GetAuthMiddleware(workspace.APIKey)
 	}
-	return apiKey
-}
 
-func initLinearClient() linearClient.LinearClient {
-	md := linearClient.GetAuthMiddleware(getApiKey())
+	path, err := config.CredentialsPath()
+	if err != nil {
+		return func(context.Context, *http.Request, *clientv2.GQLRequestInfo, any, clientv2.RequestInterceptorFunc) error {
+			return err
+		}
+	}
 
+	return linearClient.GetTokenMiddleware(auth.NewSource(path, workspace))
+}
+
+func initLinearClient(md clientv2.RequestInterceptor) linearClient.LinearClient {
 	client := linearClient.NewClient(http.DefaultClient, linearBaseUrL, nil, md)
 
 	return client
 }
 
-func initLinearRawClient() *clientv2.Client {
-	md := linearClient.GetAuthMiddleware(getApiKey())
-
+func initLinearRawClient(md clientv2.RequestInterceptor) *clientv2.Client {
 	client := clientv2.NewClient(http.DefaultClient, linearBaseUrL, nil, md)
 
 	client.CustomDo = func(
@@ -77,10 +84,35 @@ func initLinearRawClient() *clientv2.Client {
 	return client
 }
 
-func New() *Client {
+func New(workspace config.Workspace) *Client {
+	ctx, cancel := context.WithCancel(context.Background())
+	md := authMiddleware(workspace)
 	return &Client{
-		client:    initLinearClient(),
-		rawClient: initLinearRawClient(),
+		client:    initLinearClient(md),
+		rawClient: initLinearRawClient(md),
+		workspace: workspace.Name,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (c *Client) Workspace() string {
+	return c.workspace
+}
+
+// Close cancels requests in flight, responses of a closed client are dropped
+// so they don't end up in the cache of the workspace switched to.
+func (c *Client) Close() {
+	c.cancel()
+}
+
+func (c *Client) command(cmd tea.Cmd) tea.Cmd {
+	return func() tea.Msg {
+		msg := cmd()
+		if c.ctx.Err() != nil {
+			return nil
+		}
+		return msg
 	}
 }
 
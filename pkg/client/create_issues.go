@@ -0,0 +1,63 @@
+package client
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/linear/models"
+)
+
+type NewIssue struct {
+	Title       string
+	Description string
+	TeamID      string
+	StateID     string
+	AssigneeID  string
+	ProjectID   string
+	Priority    int64
+	LabelIDs    []string
+}
+
+type CreatedIssue struct {
+	ID         string
+	Identifier string
+}
+
+type CreateIssueRes Command[CreatedIssue]
+
+func optional(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func (c *Client) CreateIssue(issue NewIssue) tea.Cmd {
+	return c.command(func() tea.Msg {
+		input := models.IssueCreateInput{
+			Title:       &issue.Title,
+			Description: optional(issue.Description),
+			TeamID:      issue.TeamID,
+			StateID:     optional(issue.StateID),
+			AssigneeID:  optional(issue.AssigneeID),
+			ProjectID:   optional(issue.ProjectID),
+			Priority:    &issue.Priority,
+			LabelIds:    issue.LabelIDs,
+		}
+
+		resp, err := c.client.CreateIssue(c.ctx, input)
+		if err != nil {
+			return err
+		}
+
+		created := resp.GetIssueCreate()
+		if !created.GetSuccess() || created.GetIssue() == nil {
+			return errors.New("linear didn't create the issue")
+		}
+
+		return CreateIssueRes(response(CreatedIssue{
+			ID:         created.GetIssue().GetID(),
+			Identifier: created.GetIssue().GetIdentifier(),
+		}))
+	})
+}
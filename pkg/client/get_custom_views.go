@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/linear/models"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+type GetCustomViewsRes struct {
+	Reset bool
+	Resumable[[]store.CustomView]
+}
+
+func (c *Client) GetCustomViews(after *string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		resp, err := c.client.GetCustomViews(
+			c.ctx,
+			after,
+			first(),
+		)
+		if err != nil {
+			return err
+		}
+
+		coalece := func(n *string, c string) string {
+			if n == nil {
+				return c
+			}
+			return *n
+		}
+
+		var views []store.CustomView
+
+		for _, view := range resp.CustomViews.GetNodes() {
+			filterData, err := json.Marshal(view.GetFilterData())
+			if err != nil {
+				return fmt.Errorf("error encoding custom view filter data")
+			}
+
+			views = append(views, store.CustomView{
+				ID:          view.GetID(),
+				Name:        view.GetName(),
+				Description: coalece(view.GetDescription(), ""),
+				Color:       coalece(view.GetColor(), "#bbb"),
+				TeamID:      view.GetTeam().GetID(),
+				FilterData:  string(filterData),
+			})
+		}
+
+		return GetCustomViewsRes{
+			Reset:     after == nil,
+			Resumable: paginated(views, &resp.CustomViews.PageInfo),
+		}
+	})
+}
+
+type GetCustomViewIssuesRes struct {
+	ViewID string
+	Reset  bool
+	Resumable[[]store.Issue]
+}
+
+// GetCustomViewIssues queries linear for the issues of a view whose filter
+// can't be evaluated on the local store.
+func (c *Client) GetCustomViewIssues(view store.CustomView, after *string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		var filter models.IssueFilter
+		err := json.Unmarshal([]byte(view.FilterData), &filter)
+		if err != nil {
+			return fmt.Errorf("error decoding custom view filter data: %w", err)
+		}
+
+		if view.TeamID != "" {
+			filter = models.IssueFilter{
+				And: []*models.IssueFilter{&filter},
+				Team: &models.TeamFilter{
+					ID: &models.IDComparator{Eq: &view.TeamID},
+				},
+			}
+		}
+
+		issues, err := c.queryIssues(&filter, after)
+		if err != nil {
+			return err
+		}
+
+		return GetCustomViewIssuesRes{
+			ViewID:    view.ID,
+			Reset:     after == nil,
+			Resumable: issues,
+		}
+	})
+}
@@ -0,0 +1,32 @@
+package client
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+type GetIssueRes Command[store.Issue]
+
+// GetIssue fetches a single issue by its identifier, like ENG-123, whether
+// or not it's synced.
+func (c *Client) GetIssue(identifier string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		filter := identifierFilter(identifier)
+		if filter == nil {
+			return fmt.Errorf("%s isn't an issue identifier", identifier)
+		}
+
+		issues, err := c.queryIssues(filter, nil)
+		if err != nil {
+			return err
+		}
+
+		if len(issues.Result) == 0 {
+			return fmt.Errorf("couldn't find issue %s", identifier)
+		}
+
+		return GetIssueRes(response(issues.Result[0]))
+	})
+}
@@ -0,0 +1,118 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	linearClient "github.com/sayedmurtaza24/tinear/linear"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+// IssueActivity is what happened on an issue, its comments and the changes
+// made to it, both oldest first.
+type IssueActivity struct {
+	IssueID  string
+	Comments []store.Comment
+	History  []store.Change
+}
+
+type GetIssueActivityRes Command[IssueActivity]
+
+// GetIssueActivity fetches the comments and the history of an issue, the
+// last 50 of each.
+func (c *Client) GetIssueActivity(issueID string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		resp, err := c.client.GetIssueActivity(c.ctx, issueID)
+		if err != nil {
+			return err
+		}
+
+		activity := IssueActivity{IssueID: issueID}
+
+		for _, comment := range resp.GetIssue().GetComments().GetNodes() {
+			createdAt, err := time.Parse(time.RFC3339, comment.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("error parsing comment created_at")
+			}
+
+			activity.Comments = append(activity.Comments, store.Comment{
+				ID:        comment.ID,
+				Author:    comment.GetUser().GetDisplayName(),
+				Body:      comment.Body,
+				CreatedAt: createdAt,
+			})
+		}
+
+		for _, entry := range resp.GetIssue().GetHistory().GetNodes() {
+			createdAt, err := time.Parse(time.RFC3339, entry.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("error parsing history created_at")
+			}
+
+			activity.History = append(activity.History, historyChanges(entry, createdAt)...)
+		}
+
+		slices.SortStableFunc(activity.Comments, func(a, b store.Comment) int {
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+		slices.SortStableFunc(activity.History, func(a, b store.Change) int {
+			return a.CreatedAt.Compare(b.CreatedAt)
+		})
+
+		return GetIssueActivityRes(response(activity))
+	})
+}
+
+// historyChanges splits an entry of the history into the fields it changed,
+// entries changing nothing shown, like linking a commit, have none.
+func historyChanges(entry *linearClient.GetIssueActivity_Issue_History_Nodes, createdAt time.Time) []store.Change {
+	var changes []store.Change
+
+	change := func(field, from, to string) {
+		changes = append(changes, store.Change{
+			Actor:     entry.GetActor().GetDisplayName(),
+			Field:     field,
+			From:      from,
+			To:        to,
+			CreatedAt: createdAt,
+		})
+	}
+
+	if entry.FromState != nil || entry.ToState != nil {
+		change("state", entry.GetFromState().GetName(), entry.GetToState().GetName())
+	}
+	if entry.FromAssignee != nil || entry.ToAssignee != nil {
+		change("assignee", entry.GetFromAssignee().GetDisplayName(), entry.GetToAssignee().GetDisplayName())
+	}
+	if entry.FromPriority != nil || entry.ToPriority != nil {
+		var from, to string
+		if entry.FromPriority != nil {
+			from = store.Prio(*entry.FromPriority).String()
+		}
+		if entry.ToPriority != nil {
+			to = store.Prio(*entry.ToPriority).String()
+		}
+		change("priority", from, to)
+	}
+	if entry.FromProject != nil || entry.ToProject != nil {
+		change("project", entry.GetFromProject().GetName(), entry.GetToProject().GetName())
+	}
+	if entry.ToTitle != nil {
+		change("title", "", *entry.ToTitle)
+	}
+	if len(entry.AddedLabels) > 0 || len(entry.RemovedLabels) > 0 {
+		var removed, added []string
+		for _, label := range entry.RemovedLabels {
+			removed = append(removed, label.GetName())
+		}
+		for _, label := range entry.AddedLabels {
+			added = append(added, label.GetName())
+		}
+		change("labels", strings.Join(removed, ", "), strings.Join(added, ", "))
+	}
+
+	return changes
+}
@@ -1,7 +1,6 @@
 package client
 
 import (
-	"context"
 	"fmt"
 	"time"
 
@@ -15,7 +14,7 @@ const syncThreshold = -6 * 30 * 24 * time.Hour
 type GetIssuesRes Resumable[[]store.Issue]
 
 func (c *Client) GetIssues(lastSync time.Time, teamIDs []string, after *string) tea.Cmd {
-	return func() tea.Msg {
+	return c.command(func() tea.Msg {
 		syncedAt := lastSync.Format(time.RFC3339)
 
 		if lastSync.IsZero() {
@@ -41,97 +40,135 @@ func (c *Client) GetIssues(lastSync time.Time, teamIDs []string, after *string)
 			},
 		}
 
-		resp, err := c.client.GetIssues(
-			context.Background(),
-			&filter,
-			after,
-			first(),
-		)
+		issues, err := c.queryIssues(&filter, after)
 		if err != nil {
 			return err
 		}
 
-		coalece := func(n *string, c string) string {
-			if n == nil {
-				return c
-			}
-			return *n
+		return GetIssuesRes(issues)
+	})
+}
+
+func (c *Client) queryIssues(filter *models.IssueFilter, after *string) (Resumable[[]store.Issue], error) {
+	resp, err := c.client.GetIssues(
+		c.ctx,
+		filter,
+		after,
+		first(),
+	)
+	if err != nil {
+		return Resumable[[]store.Issue]{}, err
+	}
+
+	coalece := func(n *string, c string) string {
+		if n == nil {
+			return c
 		}
+		return *n
+	}
+
+	if resp == nil {
+		return Resumable[[]store.Issue]{}, nil
+	}
 
-		if resp == nil {
-			return []store.Issue{}
+	var issues []store.Issue
+
+	for _, iss := range resp.Issues.GetNodes() {
+		createdAt, err := time.Parse(time.RFC3339, iss.CreatedAt)
+		if err != nil {
+			return Resumable[[]store.Issue]{}, fmt.Errorf("error parsing created_at")
 		}
 
-		var issues []store.Issue
+		updatedAt, err := time.Parse(time.RFC3339, iss.UpdatedAt)
+		if err != nil {
+			return Resumable[[]store.Issue]{}, fmt.Errorf("error parsing updated_at")
+		}
 
-		for _, iss := range resp.Issues.GetNodes() {
-			createdAt, err := time.Parse(time.RFC3339, iss.CreatedAt)
+		var canceledAt *time.Time
+		if iss.CanceledAt != nil {
+			t, err := time.Parse(time.RFC3339, *iss.CanceledAt)
 			if err != nil {
-				return fmt.Errorf("error parsing created_at")
+				return Resumable[[]store.Issue]{}, fmt.Errorf("error parsing canceled_at")
 			}
+			canceledAt = &t
+		}
 
-			updatedAt, err := time.Parse(time.RFC3339, iss.UpdatedAt)
+		var dueDate *time.Time
+		if iss.DueDate != nil {
+			t, err := time.Parse(time.DateOnly, *iss.DueDate)
 			if err != nil {
-				return fmt.Errorf("error parsing updated_at")
-			}
-
-			var canceledAt *time.Time
-			if iss.CanceledAt != nil {
-				t, err := time.Parse(time.RFC3339, *iss.CanceledAt)
-				if err != nil {
-					return fmt.Errorf("error parsing canceled_at")
-				}
-				canceledAt = &t
-			}
-
-			labels := make([]store.Label, len(iss.Labels.GetNodes()))
-			for i, label := range iss.Labels.GetNodes() {
-				labels[i] = store.Label{
-					ID:     label.ID,
-					Name:   label.Name,
-					Color:  label.Color,
-					TeamID: label.GetTeam().GetID(),
-				}
+				return Resumable[[]store.Issue]{}, fmt.Errorf("error parsing due_date")
 			}
+			dueDate = &t
+		}
 
-			is := store.Issue{
-				ID:          iss.GetID(),
-				Identifier:  iss.GetIdentifier(),
-				Title:       iss.GetTitle(),
-				Description: coalece(iss.Description, ""),
-				Assignee: store.User{
-					ID:          iss.GetAssignee().GetID(),
-					Name:        iss.GetAssignee().GetName(),
-					DisplayName: iss.GetAssignee().GetDisplayName(),
-					Email:       iss.GetAssignee().GetEmail(),
-					IsMe:        iss.GetAssignee().GetIsMe(),
-				},
-				Labels:   labels,
-				Priority: store.Prio(iss.GetPriority()),
-				Team: store.Team{
-					ID:    iss.GetTeam().GetID(),
-					Name:  iss.GetTeam().GetName(),
-					Color: coalece(iss.GetTeam().GetColor(), "#bbb"),
-				},
-				State: store.State{
-					ID:     iss.GetState().GetID(),
-					Name:   iss.GetState().GetName(),
-					Color:  iss.GetState().GetColor(),
-					TeamID: iss.GetState().GetTeam().GetID(),
-				},
-				Project: store.Project{
-					ID:    iss.GetProject().GetID(),
-					Name:  iss.GetProject().GetName(),
-					Color: iss.GetProject().GetColor(),
-				},
-				CreatedAt:  createdAt,
-				UpdatedAt:  updatedAt,
-				CanceledAt: canceledAt,
+		labels := make([]store.Label, len(iss.Labels.GetNodes()))
+		for i, label := range iss.Labels.GetNodes() {
+			labels[i] = store.Label{
+				ID:     label.ID,
+				Name:   label.Name,
+				Color:  label.Color,
+				TeamID: label.GetTeam().GetID(),
 			}
+		}
 
-			issues = append(issues, is)
+		is := store.Issue{
+			ID:          iss.GetID(),
+			Identifier:  iss.GetIdentifier(),
+			Title:       iss.GetTitle(),
+			BranchName:  iss.GetBranchName(),
+			Description: coalece(iss.Description, ""),
+			Assignee: store.User{
+				ID:          iss.GetAssignee().GetID(),
+				Name:        iss.GetAssignee().GetName(),
+				DisplayName: iss.GetAssignee().GetDisplayName(),
+				Email:       iss.GetAssignee().GetEmail(),
+				IsMe:        iss.GetAssignee().GetIsMe(),
+			},
+			Labels:   labels,
+			Priority: store.Prio(iss.GetPriority()),
+			Team: store.Team{
+				ID:    iss.GetTeam().GetID(),
+				Name:  iss.GetTeam().GetName(),
+				Color: coalece(iss.GetTeam().GetColor(), "#bbb"),
+			},
+			State: store.State{
+				ID:       iss.GetState().GetID(),
+				Name:     iss.GetState().GetName(),
+				Color:    iss.GetState().GetColor(),
+				Type:     iss.GetState().GetType(),
+				Position: iss.GetState().GetPosition(),
+				TeamID:   iss.GetState().GetTeam().GetID(),
+			},
+			Project: store.Project{
+				ID:    iss.GetProject().GetID(),
+				Name:  iss.GetProject().GetName(),
+				Color: iss.GetProject().GetColor(),
+			},
+			Milestone: store.ProjectMilestone{
+				ID:   iss.GetProjectMilestone().GetID(),
+				Name: iss.GetProjectMilestone().GetName(),
+			},
+			Cycle: store.Cycle{
+				Number: int(iss.GetCycle().GetNumber()),
+				Name:   coalece(iss.GetCycle().GetName(), ""),
+			},
+			Creator: store.User{
+				ID:          iss.GetCreator().GetID(),
+				Name:        iss.GetCreator().GetName(),
+				DisplayName: iss.GetCreator().GetDisplayName(),
+				Email:       iss.GetCreator().GetEmail(),
+				IsMe:        iss.GetCreator().GetIsMe(),
+			},
+			Estimate:   iss.GetEstimate(),
+			DueDate:    dueDate,
+			CreatedAt:  createdAt,
+			UpdatedAt:  updatedAt,
+			CanceledAt: canceledAt,
 		}
 
-		return GetIssuesRes(paginated(issues, &resp.Issues.PageInfo))
+		issues = append(issues, is)
 	}
+
+	return paginated(issues, &resp.Issues.PageInfo), nil
 }
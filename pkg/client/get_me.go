@@ -1,8 +1,6 @@
 package client
 
 import (
-	"context"
-
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
 )
@@ -18,8 +16,8 @@ type Me struct {
 type GetMeRes Command[Me]
 
 func (c *Client) GetMe() tea.Cmd {
-	return func() tea.Msg {
-		resp, err := c.client.GetMe(context.Background())
+	return c.command(func() tea.Msg {
+		resp, err := c.client.GetMe(c.ctx)
 		if err != nil {
 			return err
 		}
@@ -33,9 +31,10 @@ func (c *Client) GetMe() tea.Cmd {
 				IsMe:        true,
 			},
 			Org: store.Org{
-				ID:     resp.Viewer.Organization.ID,
-				Name:   resp.Viewer.Organization.Name,
-				URLKey: resp.Viewer.Organization.URLKey,
+				ID:        resp.Viewer.Organization.ID,
+				Name:      resp.Viewer.Organization.Name,
+				URLKey:    resp.Viewer.Organization.URLKey,
+				Workspace: c.workspace,
 			},
 		}
 
@@ -55,10 +54,12 @@ func (c *Client) GetMe() tea.Cmd {
 
 			for _, state := range team.States.GetNodes() {
 				me.States = append(me.States, store.State{
-					ID:     state.ID,
-					Name:   state.Name,
-					Color:  state.Color,
-					TeamID: team.ID,
+					ID:       state.ID,
+					Name:     state.Name,
+					Color:    state.Color,
+					Type:     state.Type,
+					Position: state.Position,
+					TeamID:   team.ID,
 				})
 			}
 
@@ -76,5 +77,5 @@ func (c *Client) GetMe() tea.Cmd {
 		}
 
 		return GetMeRes(response(me))
-	}
+	})
 }
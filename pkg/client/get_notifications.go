@@ -0,0 +1,67 @@
+package client
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+type GetNotificationsRes Resumable[[]store.Notification]
+
+func (c *Client) GetNotifications(after *string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		resp, err := c.client.GetNotifications(c.ctx, after, first())
+		if err != nil {
+			return err
+		}
+
+		parseOptional := func(s *string) (*time.Time, error) {
+			if s == nil {
+				return nil, nil
+			}
+			t, err := time.Parse(time.RFC3339, *s)
+			if err != nil {
+				return nil, err
+			}
+			return &t, nil
+		}
+
+		var notifications []store.Notification
+
+		for _, notif := range resp.Notifications.GetNodes() {
+			createdAt, err := time.Parse(time.RFC3339, notif.CreatedAt)
+			if err != nil {
+				return fmt.Errorf("error parsing notification created_at")
+			}
+
+			readAt, err := parseOptional(notif.ReadAt)
+			if err != nil {
+				return fmt.Errorf("error parsing notification read_at")
+			}
+
+			snoozedUntilAt, err := parseOptional(notif.SnoozedUntilAt)
+			if err != nil {
+				return fmt.Errorf("error parsing notification snoozed_until_at")
+			}
+
+			issue := notif.IssueNotification.GetIssue()
+
+			notifications = append(notifications, store.Notification{
+				ID:              notif.GetID(),
+				Type:            notif.GetType(),
+				Title:           notif.GetTitle(),
+				Subtitle:        notif.GetSubtitle(),
+				ActorName:       notif.GetActor().GetDisplayName(),
+				IssueID:         issue.GetID(),
+				IssueIdentifier: issue.GetIdentifier(),
+				ReadAt:          readAt,
+				SnoozedUntilAt:  snoozedUntilAt,
+				CreatedAt:       createdAt,
+			})
+		}
+
+		return GetNotificationsRes(paginated(notifications, &resp.Notifications.PageInfo))
+	})
+}
@@ -1,7 +1,8 @@
 package client
 
 import (
-	"context"
+	"fmt"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
@@ -10,9 +11,9 @@ import (
 type GetProjectsRes Resumable[[]store.Project]
 
 func (c *Client) GetProjects(after *string) tea.Cmd {
-	return func() tea.Msg {
+	return c.command(func() tea.Msg {
 		resp, err := c.client.GetProjects(
-			context.Background(),
+			c.ctx,
 			after,
 			first(),
 		)
@@ -20,16 +21,83 @@ func (c *Client) GetProjects(after *string) tea.Cmd {
 			return err
 		}
 
+		coalece := func(n *string, c string) string {
+			if n == nil {
+				return c
+			}
+			return *n
+		}
+
+		parseDate := func(s *string) (*time.Time, error) {
+			if s == nil {
+				return nil, nil
+			}
+			t, err := time.Parse(time.DateOnly, *s)
+			if err != nil {
+				return nil, err
+			}
+			return &t, nil
+		}
+
 		var projects []store.Project
 
 		for _, proj := range resp.Projects.GetNodes() {
+			startDate, err := parseDate(proj.StartDate)
+			if err != nil {
+				return fmt.Errorf("error parsing project start_date")
+			}
+
+			targetDate, err := parseDate(proj.TargetDate)
+			if err != nil {
+				return fmt.Errorf("error parsing project target_date")
+			}
+
+			var health string
+			if proj.Health != nil {
+				health = string(*proj.Health)
+			}
+
+			var teams []store.Team
+			for _, team := range proj.Teams.GetNodes() {
+				teams = append(teams, store.Team{
+					ID:    team.ID,
+					Name:  team.Name,
+					Color: coalece(team.Color, "#bbb"),
+				})
+			}
+
+			var milestones []store.ProjectMilestone
+			for _, milestone := range proj.ProjectMilestones.GetNodes() {
+				milestoneTargetDate, err := parseDate(milestone.TargetDate)
+				if err != nil {
+					return fmt.Errorf("error parsing milestone target_date")
+				}
+
+				milestones = append(milestones, store.ProjectMilestone{
+					ID:         milestone.ID,
+					Name:       milestone.Name,
+					TargetDate: milestoneTargetDate,
+					SortOrder:  milestone.SortOrder,
+					ProjectID:  proj.ID,
+				})
+			}
+
 			projects = append(projects, store.Project{
-				ID:    proj.ID,
-				Name:  proj.Name,
-				Color: proj.Color,
+				ID:          proj.ID,
+				Name:        proj.Name,
+				Color:       proj.Color,
+				Description: proj.Description,
+				State:       proj.State,
+				LeadName:    proj.GetLead().GetDisplayName(),
+				Health:      health,
+				Progress:    proj.Progress,
+				StartDate:   startDate,
+				TargetDate:  targetDate,
+				Teams:       teams,
+				Milestones:  milestones,
 			})
 		}
 
 		return GetProjectsRes(paginated(projects, &resp.Projects.PageInfo))
-	}
+	})
 }
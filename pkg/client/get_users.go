@@ -1,8 +1,6 @@
 package client
 
 import (
-	"context"
-
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
 )
@@ -10,8 +8,8 @@ import (
 type GetUsersRes Resumable[[]store.User]
 
 func (c *Client) GetUsers(after *string) tea.Cmd {
-	return func() tea.Msg {
-		resp, err := c.client.GetAllUsers(context.Background(), after, first())
+	return c.command(func() tea.Msg {
+		resp, err := c.client.GetAllUsers(c.ctx, after, first())
 		if err != nil {
 			return err
 		}
@@ -29,5 +27,5 @@ func (c *Client) GetUsers(after *string) tea.Cmd {
 		}
 
 		return GetUsersRes(paginated(users, &resp.Users.PageInfo))
-	}
+	})
 }
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/linear/models"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+var matchIdentifier = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]*)-(\d+)$`)
+
+// identifierFilter matches the issue identified by identifier, like ENG-123,
+// it's nil if identifier isn't one.
+func identifierFilter(identifier string) *models.IssueFilter {
+	match := matchIdentifier.FindStringSubmatch(identifier)
+	if match == nil {
+		return nil
+	}
+
+	key := strings.ToUpper(match[1])
+	number, _ := strconv.ParseFloat(match[2], 64)
+
+	return &models.IssueFilter{
+		Number: &models.NumberComparator{Eq: &number},
+		Team: &models.TeamFilter{
+			Key: &models.StringComparator{Eq: &key},
+		},
+	}
+}
+
+// SearchIssuesRes are the issues found searching linear for Search, only the
+// first page of them.
+type SearchIssuesRes struct {
+	Search string
+	Issues []store.Issue
+}
+
+// SearchIssues searches linear itself, it finds issues too old to be synced.
+// Every word has to be in the title or description, words like ENG-123 match
+// the identifier instead.
+func (c *Client) SearchIssues(search string, teamIDs []string) tea.Cmd {
+	return c.command(func() tea.Msg {
+		filter := models.IssueFilter{
+			Team: &models.TeamFilter{
+				ID: &models.IDComparator{
+					In: teamIDs,
+				},
+			},
+		}
+
+		for _, word := range strings.Fields(search) {
+			if identifier := identifierFilter(word); identifier != nil {
+				filter.And = append(filter.And, identifier)
+				continue
+			}
+
+			filter.And = append(filter.And, &models.IssueFilter{
+				Or: []*models.IssueFilter{
+					{
+						Title: &models.StringComparator{ContainsIgnoreCase: &word},
+					},
+					{
+						Description: &models.NullableStringComparator{ContainsIgnoreCase: &word},
+					},
+				},
+			})
+		}
+
+		issues, err := c.queryIssues(&filter, nil)
+		if err != nil {
+			return err
+		}
+
+		return SearchIssuesRes{
+			Search: search,
+			Issues: issues.Result,
+		}
+	})
+}
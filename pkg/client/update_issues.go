@@ -1,7 +1,6 @@
 package client
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
@@ -98,6 +97,13 @@ func WithSetProject(projectID string) IssueUpdateOpt {
 	}
 }
 
+func WithSetMilestone(milestoneID string) IssueUpdateOpt {
+	return func(i *issueUpdateOpt) {
+		i.hasOpt = true
+		i.opt.ProjectMilestoneID = &milestoneID
+	}
+}
+
 func WithSetTeam(teamID string) IssueUpdateOpt {
 	return func(i *issueUpdateOpt) {
 		i.hasOpt = true
@@ -118,7 +124,7 @@ type UpdateIssuesResponse struct {
 }
 
 func (c *Client) UpdateIssues(issueIDs []string, onFail tea.Cmd, opts ...IssueUpdateOpt) tea.Cmd {
-	return func() tea.Msg {
+	return c.command(func() tea.Msg {
 		var response UpdateIssuesResponse
 		var input issueUpdateOpt
 
@@ -127,7 +133,7 @@ func (c *Client) UpdateIssues(issueIDs []string, onFail tea.Cmd, opts ...IssueUp
 		}
 
 		if input.hasOpt {
-			resp, err := c.client.BatchUpdateIssues(context.Background(), input.opt, issueIDs)
+			resp, err := c.client.BatchUpdateIssues(c.ctx, input.opt, issueIDs)
 			if err != nil {
 				return err
 			}
@@ -140,7 +146,7 @@ func (c *Client) UpdateIssues(issueIDs []string, onFail tea.Cmd, opts ...IssueUp
 			})
 
 			query, args := buildUpdateLabelQuery(input.labelsMut, input.label, issueIDs...)
-			err := c.rawClient.Post(context.Background(), "", query, &resp, args)
+			err := c.rawClient.Post(c.ctx, "", query, &resp, args)
 			if err != nil {
 				return err
 			}
@@ -157,5 +163,5 @@ func (c *Client) UpdateIssues(issueIDs []string, onFail tea.Cmd, opts ...IssueUp
 		}
 
 		return response
-	}
+	})
 }
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/linear/models"
+)
+
+type NotificationUpdateOpt func(*models.NotificationUpdateInput)
+
+func WithRead(read bool) NotificationUpdateOpt {
+	return func(i *models.NotificationUpdateInput) {
+		readAt := models.NullString
+		if read {
+			readAt = time.Now().UTC().Format(time.RFC3339)
+		}
+		i.ReadAt = &readAt
+	}
+}
+
+func WithSnoozedUntil(until time.Time) NotificationUpdateOpt {
+	return func(i *models.NotificationUpdateInput) {
+		snoozedUntil := until.UTC().Format(time.RFC3339)
+		i.SnoozedUntilAt = &snoozedUntil
+	}
+}
+
+type UpdateNotificationsResponse struct {
+	Success       bool
+	OnFailCommand tea.Cmd
+}
+
+func (c *Client) UpdateNotifications(notificationIDs []string, onFail tea.Cmd, opts ...NotificationUpdateOpt) tea.Cmd {
+	return c.command(func() tea.Msg {
+		var input models.NotificationUpdateInput
+
+		for _, opt := range opts {
+			opt(&input)
+		}
+
+		response := UpdateNotificationsResponse{
+			Success:       true,
+			OnFailCommand: onFail,
+		}
+
+		// linear has no batch update for notifications
+		for _, id := range notificationIDs {
+			resp, err := c.client.UpdateNotification(c.ctx, id, input)
+			if err != nil {
+				return err
+			}
+			if !resp.GetNotificationUpdate().GetSuccess() {
+				response.Success = false
+			}
+		}
+
+		return response
+	})
+}
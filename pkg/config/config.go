@@ -0,0 +1,172 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	defaultAuthorizeURL = "https://linear.app/oauth/authorize"
+	defaultTokenURL     = "https://api.linear.app/oauth/token"
+)
+
+type OAuth struct {
+	ClientID     string   `yaml:"client_id"`
+	ClientSecret string   `yaml:"client_secret"`
+	AuthorizeURL string   `yaml:"authorize_url"`
+	TokenURL     string   `yaml:"token_url"`
+	Scopes       []string `yaml:"scopes"`
+	// 0 picks a free port, linear apps need the full redirect uri registered though
+	CallbackPort int `yaml:"callback_port"`
+}
+
+type Workspace struct {
+	Name   string `yaml:"name"`
+	APIKey string `yaml:"api_key"`
+	OAuth  OAuth  `yaml:"oauth"`
+}
+
+// Keys are bound to an action, written as a single key or a list of them.
+type Keys []string
+
+func (k *Keys) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		// NOTE: an empty key unbinds the action, same as an empty list
+		if node.Value == "" || node.ShortTag() == "!!null" {
+			*k = Keys{}
+			return nil
+		}
+		*k = Keys{node.Value}
+		return nil
+	}
+
+	var keys []string
+	err := node.Decode(&keys)
+	if err != nil {
+		return err
+	}
+
+	*k = keys
+	return nil
+}
+
+type Config struct {
+	OAuth      OAuth       `yaml:"oauth"`
+	Workspaces []Workspace `yaml:"workspaces"`
+	// git repositories scanned for commits mentioning issues
+	Repositories []string `yaml:"repositories"`
+	// keys of actions overriding the default ones, like `filter: /`
+	Keys map[string]Keys `yaml:"keys"`
+	// dark, light, high-contrast or one of themes.yml, picked after the
+	// terminal when empty
+	Theme string `yaml:"theme"`
+}
+
+func (o OAuth) withDefaults(base OAuth) OAuth {
+	if o.ClientID == "" {
+		o.ClientID = base.ClientID
+	}
+	if o.ClientSecret == "" {
+		o.ClientSecret = base.ClientSecret
+	}
+	if o.AuthorizeURL == "" {
+		o.AuthorizeURL = base.AuthorizeURL
+	}
+	if o.TokenURL == "" {
+		o.TokenURL = base.TokenURL
+	}
+	if len(o.Scopes) == 0 {
+		o.Scopes = base.Scopes
+	}
+	if o.CallbackPort == 0 {
+		o.CallbackPort = base.CallbackPort
+	}
+	return o
+}
+
+func Dir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf("couldn't find config dir: %w", err)
+	}
+	return filepath.Join(dir, "tinear"), nil
+}
+
+func CredentialsPath() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "credentials.json"), nil
+}
+
+func Load() (*Config, error) {
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+
+	return LoadFile(filepath.Join(dir, "config.yml"))
+}
+
+func LoadFile(path string) (*Config, error) {
+	var cfg Config
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("couldn't read config: %w", err)
+	}
+
+	err = yaml.Unmarshal(data, &cfg)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't parse config: %w", err)
+	}
+
+	// NOTE: keeps the single workspace setup through the env working
+	if len(cfg.Workspaces) == 0 {
+		cfg.Workspaces = append(cfg.Workspaces, Workspace{
+			Name:   "default",
+			APIKey: os.Getenv("LINEAR_API_KEY"),
+		})
+	}
+
+	cfg.OAuth = cfg.OAuth.withDefaults(OAuth{
+		AuthorizeURL: defaultAuthorizeURL,
+		TokenURL:     defaultTokenURL,
+		Scopes:       []string{"read", "write"},
+	})
+
+	for i, ws := range cfg.Workspaces {
+		if ws.Name == "" {
+			return nil, fmt.Errorf("workspace %d has no name", i+1)
+		}
+		cfg.Workspaces[i].OAuth = ws.OAuth.withDefaults(cfg.OAuth)
+	}
+
+	for i, repo := range cfg.Repositories {
+		if !strings.HasPrefix(repo, "~/") {
+			continue
+		}
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("couldn't expand repository path: %w", err)
+		}
+		cfg.Repositories[i] = filepath.Join(home, repo[2:])
+	}
+
+	return &cfg, nil
+}
+
+func (c *Config) Workspace(name string) (Workspace, bool) {
+	for _, ws := range c.Workspaces {
+		if ws.Name == name {
+			return ws, true
+		}
+	}
+	return Workspace{}, false
+}
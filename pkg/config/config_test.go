@@ -0,0 +1,40 @@
+package config
+
+import (
+	"slices"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestKeys(t *testing.T) {
+	tests := []struct {
+		yaml string
+		keys Keys
+	}{
+		{yaml: "filter: /", keys: Keys{"/"}},
+		{yaml: "filter: [/, f]", keys: Keys{"/", "f"}},
+		{yaml: "filter: []", keys: Keys{}},
+		{yaml: `filter: ""`, keys: Keys{}},
+		{yaml: "filter: ~", keys: Keys{}},
+		{yaml: "filter:", keys: Keys{}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.yaml, func(t *testing.T) {
+			var keys map[string]Keys
+			err := yaml.Unmarshal([]byte(test.yaml), &keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, ok := keys["filter"]
+			if !ok {
+				t.Fatal("expected filter to be bound")
+			}
+			if !slices.Equal(got, test.keys) {
+				t.Errorf("expected %q, got %q", test.keys, got)
+			}
+		})
+	}
+}
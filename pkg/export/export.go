@@ -0,0 +1,234 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+type Format string
+
+const (
+	FormatCSV      Format = "csv"
+	FormatJSON     Format = "json"
+	FormatMarkdown Format = "markdown"
+)
+
+var Formats = []Format{FormatCSV, FormatJSON, FormatMarkdown}
+
+// DefaultColumns are the columns of the issues table of the all issues view.
+var DefaultColumns = []string{"project", "title", "assignee", "state", "prio", "age", "team", "labels"}
+
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(s) {
+	case "csv":
+		return FormatCSV, nil
+	case "json":
+		return FormatJSON, nil
+	case "markdown", "md":
+		return FormatMarkdown, nil
+	}
+	return "", fmt.Errorf("unknown export format %s, use csv, json or markdown", s)
+}
+
+// Columns keeps the columns that can be exported, the identifier always
+// comes first since it's what the issue is known by.
+func Columns(columns []string) []string {
+	cols := []string{"id"}
+	for _, col := range columns {
+		switch col {
+		case "identifier":
+			// NOTE: it's the id column, which is always there
+		case "project", "milestone", "removed", "title", "assignee", "creator", "state", "prio",
+			"estimate", "age", "updated", "due", "cycle", "team", "labels":
+			cols = append(cols, col)
+		}
+	}
+	return cols
+}
+
+func Write(w io.Writer, format Format, columns []string, issues []store.Issue) error {
+	columns = Columns(columns)
+
+	switch format {
+	case FormatCSV:
+		return writeCSV(w, columns, issues)
+	case FormatJSON:
+		return writeJSON(w, columns, issues)
+	case FormatMarkdown:
+		return writeMarkdown(w, columns, issues)
+	}
+
+	return fmt.Errorf("unknown export format %s", format)
+}
+
+func header(column string) string {
+	// NOTE: the age shown in the table is only meaningful at the time it's read
+	if column == "age" {
+		return "created"
+	}
+	return column
+}
+
+func labels(issue store.Issue) []string {
+	names := make([]string, 0, len(issue.Labels))
+	for _, label := range issue.Labels {
+		names = append(names, label.Name)
+	}
+	return names
+}
+
+func value(issue store.Issue, column string) string {
+	switch column {
+	case "id":
+		return issue.Identifier
+	case "project":
+		if issue.Project.Name == "(No Project)" {
+			return ""
+		}
+		return issue.Project.Name
+	case "milestone":
+		return issue.Milestone.Name
+	case "removed":
+		if issue.ArchivedAt == nil {
+			return ""
+		}
+		removed := "archived"
+		if issue.Trashed {
+			removed = "deleted"
+		}
+		return fmt.Sprintf("%s %s", removed, issue.ArchivedAt.Format(time.DateOnly))
+	case "title":
+		return issue.Title
+	case "assignee":
+		return issue.Assignee.DisplayName
+	case "creator":
+		return issue.Creator.DisplayName
+	case "state":
+		return issue.State.Name
+	case "prio":
+		if issue.Priority == 0 {
+			return ""
+		}
+		return issue.Priority.String()
+	case "estimate":
+		if issue.Estimate == nil {
+			return ""
+		}
+		return strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+	case "age":
+		return issue.CreatedAt.Format(time.DateOnly)
+	case "updated":
+		return issue.UpdatedAt.Format(time.DateOnly)
+	case "due":
+		if issue.DueDate == nil {
+			return ""
+		}
+		return issue.DueDate.Format(time.DateOnly)
+	case "cycle":
+		if issue.Cycle.Name == "" && issue.Cycle.Number > 0 {
+			return fmt.Sprintf("cycle %d", issue.Cycle.Number)
+		}
+		return issue.Cycle.Name
+	case "team":
+		return issue.Team.Name
+	case "labels":
+		return strings.Join(labels(issue), ", ")
+	}
+	return ""
+}
+
+func writeCSV(w io.Writer, columns []string, issues []store.Issue) error {
+	cw := csv.NewWriter(w)
+
+	headers := make([]string, 0, len(columns))
+	for _, col := range columns {
+		headers = append(headers, header(col))
+	}
+
+	err := cw.Write(headers)
+	if err != nil {
+		return fmt.Errorf("couldn't write csv header: %w", err)
+	}
+
+	for _, issue := range issues {
+		record := make([]string, 0, len(columns))
+		for _, col := range columns {
+			record = append(record, value(issue, col))
+		}
+
+		err = cw.Write(record)
+		if err != nil {
+			return fmt.Errorf("couldn't write csv record: %w", err)
+		}
+	}
+
+	cw.Flush()
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("couldn't write csv: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(w io.Writer, columns []string, issues []store.Issue) error {
+	records := make([]map[string]any, 0, len(issues))
+	for _, issue := range issues {
+		record := make(map[string]any, len(columns))
+		for _, col := range columns {
+			if col == "labels" {
+				record[header(col)] = labels(issue)
+				continue
+			}
+			record[header(col)] = value(issue, col)
+		}
+		records = append(records, record)
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	err := enc.Encode(records)
+	if err != nil {
+		return fmt.Errorf("couldn't write json: %w", err)
+	}
+
+	return nil
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\n", " ")
+
+func writeMarkdown(w io.Writer, columns []string, issues []store.Issue) error {
+	var b strings.Builder
+
+	b.WriteString("|")
+	for _, col := range columns {
+		fmt.Fprintf(&b, " %s |", header(col))
+	}
+	b.WriteString("\n|")
+	for range columns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+
+	for _, issue := range issues {
+		b.WriteString("|")
+		for _, col := range columns {
+			fmt.Fprintf(&b, " %s |", markdownEscaper.Replace(value(issue, col)))
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	if err != nil {
+		return fmt.Errorf("couldn't write markdown: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,165 @@
+package git
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var ErrNotARepo = errors.New("not inside a git repository")
+
+var matchIdentifier = regexp.MustCompile(`(?i)\b([a-z][a-z0-9]{0,6}-[0-9]+)\b`)
+
+type Commit struct {
+	SHA        string
+	Branch     string
+	Author     string
+	Subject    string
+	Body       string
+	AuthoredAt time.Time
+}
+
+// Identifiers finds everything looking like an issue identifier, in the
+// uppercase form linear uses.
+func Identifiers(s string) []string {
+	var identifiers []string
+	seen := make(map[string]struct{})
+
+	for _, match := range matchIdentifier.FindAllStringSubmatch(s, -1) {
+		identifier := strings.ToUpper(match[1])
+		if _, ok := seen[identifier]; ok {
+			continue
+		}
+		seen[identifier] = struct{}{}
+		identifiers = append(identifiers, identifier)
+	}
+
+	return identifiers
+}
+
+// Identifiers of the commit are taken from the branch it was found on and
+// its message.
+func (c Commit) Identifiers() []string {
+	return Identifiers(c.Branch + "\n" + c.Subject + "\n" + c.Body)
+}
+
+func run(args ...string) (string, error) {
+	return runIn("", args...)
+}
+
+func runIn(dir string, args ...string) (string, error) {
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if strings.Contains(msg, "not a git repository") {
+			return "", ErrNotARepo
+		}
+		if msg == "" {
+			return "", fmt.Errorf("git %s failed: %w", args[0], err)
+		}
+		return "", fmt.Errorf("git %s failed: %s", args[0], msg)
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
+
+func CurrentBranch() (string, error) {
+	branch, err := run("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", err
+	}
+
+	// NOTE: detached heads have no branch to match an issue against
+	if branch == "HEAD" {
+		return "", nil
+	}
+
+	return branch, nil
+}
+
+func BranchExists(branch string) bool {
+	_, err := run("rev-parse", "--verify", "--quiet", "refs/heads/"+branch)
+	return err == nil
+}
+
+// Checkout switches to branch, creating it off the current HEAD when it
+// doesn't exist yet.
+func Checkout(branch string) (created bool, err error) {
+	if branch == "" {
+		return false, errors.New("no branch name given")
+	}
+
+	if BranchExists(branch) {
+		_, err = run("checkout", branch)
+		return false, err
+	}
+
+	_, err = run("checkout", "-b", branch)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Log lists commits of all local branches in repo since the given time,
+// each with the branch it was reached from.
+func Log(repo string, since time.Time) ([]Commit, error) {
+	const (
+		fieldSep  = "\x1f"
+		recordSep = "\x1e"
+	)
+
+	out, err := runIn(repo,
+		"log", "--branches", "--source",
+		"--since="+since.Format(time.RFC3339),
+		"--format=%H%x1f%S%x1f%an%x1f%aI%x1f%s%x1f%b%x1e",
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []Commit
+	for _, record := range strings.Split(out, recordSep) {
+		fields := strings.Split(strings.TrimSpace(record), fieldSep)
+		if len(fields) != 6 {
+			continue
+		}
+
+		authoredAt, err := time.Parse(time.RFC3339, fields[3])
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse date of commit %s: %w", fields[0], err)
+		}
+
+		commits = append(commits, Commit{
+			SHA:        fields[0],
+			Branch:     strings.TrimPrefix(fields[1], "refs/heads/"),
+			Author:     fields[2],
+			AuthoredAt: authoredAt,
+			Subject:    fields[4],
+			Body:       strings.TrimSpace(fields[5]),
+		})
+	}
+
+	return commits, nil
+}
+
+// HooksDir is where git looks for hooks of the current repository, which
+// honors core.hooksPath.
+func HooksDir() (string, error) {
+	dir, err := run("rev-parse", "--path-format=absolute", "--git-path", "hooks")
+	if err != nil {
+		return "", err
+	}
+	return dir, nil
+}
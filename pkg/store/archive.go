@@ -0,0 +1,119 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// ArchiveIssues hides issues from everything but the archive and drops them
+// from the search index.
+func (s *Store) ArchiveIssues(issueIDs ...string) error {
+	return s.removeIssues(false, issueIDs...)
+}
+
+// TrashIssues is ArchiveIssues for deleted issues, they stay restorable for
+// as long as linear keeps them in its trash.
+func (s *Store) TrashIssues(issueIDs ...string) error {
+	return s.removeIssues(true, issueIDs...)
+}
+
+func (s *Store) removeIssues(trashed bool, issueIDs ...string) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start remove issues tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := sqlx.In(
+		`UPDATE issues SET archived_at = ?, trashed = ? WHERE id IN (?)`,
+		time.Now(),
+		trashed,
+		issueIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't generate remove issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't remove issues: %w", err)
+	}
+
+	query, args, err = sqlx.In(`DELETE FROM search WHERE id IN (?)`, issueIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate unindex issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't unindex removed issues: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit remove issues tx: %w", err)
+	}
+
+	return nil
+}
+
+// RestoreIssues brings archived or deleted issues back and indexes them again.
+func (s *Store) RestoreIssues(issueIDs ...string) error {
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start restore issues tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := sqlx.In(
+		`UPDATE issues SET archived_at = NULL, trashed = FALSE, updated_at = ? WHERE id IN (?)`,
+		time.Now(),
+		issueIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't generate restore issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't restore issues: %w", err)
+	}
+
+	// NOTE: clear first, restoring an issue that was never removed mustn't index it twice
+	query, args, err = sqlx.In(`DELETE FROM search WHERE id IN (?)`, issueIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate unindex issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't unindex restored issues: %w", err)
+	}
+
+	query, args, err = sqlx.In(fmt.Sprintf(searchIndexInsert, `issues.id IN (?)`), issueIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate index issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't index restored issues: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit restore issues tx: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+package store
+
+import (
+	"fmt"
+)
+
+func (s *Store) Commits(issueID string) ([]Commit, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var commits []Commit
+	err := s.db.Select(&commits, fmt.Sprintf(`
+		SELECT commits.sha, commits.repo, commits.branch, commits.author,
+			commits.subject, commits.authored_at, commits.issue_identifier
+		FROM commits
+		JOIN issues ON issues.identifier = commits.issue_identifier
+		WHERE issues.id = ? AND issues.org_id = %s
+		ORDER BY commits.authored_at DESC`, currentOrg),
+		issueID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select commits: %w", err)
+	}
+
+	return commits, nil
+}
+
+// StoreCommits links commits to the identifiers they mention, commits aren't
+// bound to an org since the identifiers are only resolved when read.
+func (s *Store) StoreCommits(commits []Commit) error {
+	commits = removeDuplicatesAndEmpties(commits)
+	if len(commits) == 0 {
+		return nil
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start store commits tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// NOTE: sqlite caps the number of variables of a single statement
+	const batch = 500
+	for start := 0; start < len(commits); start += batch {
+		end := min(start+batch, len(commits))
+
+		_, err = tx.NamedExec(`
+			INSERT INTO commits (sha, repo, branch, author, subject, authored_at, issue_identifier)
+			VALUES (:sha, :repo, :branch, :author, :subject, :authored_at, :issue_identifier)
+			ON CONFLICT (sha, issue_identifier) DO UPDATE
+			SET repo = EXCLUDED.repo,
+				branch = EXCLUDED.branch`,
+			commits[start:end],
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store commits: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit store commits tx: %w", err)
+	}
+
+	return nil
+}
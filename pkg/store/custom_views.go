@@ -0,0 +1,153 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// builtinCustomViews only live in tinear, their ids can't clash with linear's uuids.
+var builtinCustomViews = []CustomView{
+	{
+		ID:          "tinear-committed-not-started",
+		Name:        "Committed, not started",
+		Description: "Issues with local commits that are still in todo",
+		Color:       "#c8a35a",
+		FilterData:  `{"hasCommits": {"eq": true}, "state": {"type": {"in": ["triage", "backlog", "unstarted"]}}}`,
+	},
+}
+
+func (s *Store) CustomViews() ([]CustomView, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var views []CustomView
+	err := s.db.Select(&views, fmt.Sprintf(`
+		SELECT id, name, description, color,
+			COALESCE(team_id, '') AS team_id, filter_data
+		FROM custom_views
+		WHERE org_id = %s
+		ORDER BY name`, currentOrg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select custom views: %w", err)
+	}
+
+	return append(views, builtinCustomViews...), nil
+}
+
+func (s *Store) StoreCustomViews(views []CustomView, reset bool) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	views = removeDuplicatesAndEmpties(views)
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start store custom views tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	// views deleted on linear are only noticed on a full sync
+	if reset {
+		var viewIDs []string
+		for _, view := range views {
+			viewIDs = append(viewIDs, view.ID)
+		}
+
+		query := fmt.Sprintf("DELETE FROM custom_views WHERE org_id = %s", currentOrg)
+		var args []any
+		if len(viewIDs) > 0 {
+			query, args, err = sqlx.In(query+" AND id NOT IN (?)", viewIDs)
+			if err != nil {
+				return fmt.Errorf("couldn't generate delete custom views query: %w", err)
+			}
+		}
+
+		_, err = tx.Exec(query, args...)
+		if err != nil {
+			return fmt.Errorf("couldn't delete removed custom views: %w", err)
+		}
+	}
+
+	if len(views) > 0 {
+		_, err = tx.NamedExec(fmt.Sprintf(`
+			INSERT INTO custom_views (id, name, description, color, team_id, filter_data, org_id)
+			VALUES (:id, :name, :description, :color, NULLIF(:team_id, ''), :filter_data, %s)
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name,
+				description = EXCLUDED.description,
+				color = EXCLUDED.color,
+				team_id = EXCLUDED.team_id,
+				filter_data = EXCLUDED.filter_data
+			`, currentOrg),
+			views,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store custom views: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit store custom views tx: %w", err)
+	}
+
+	return nil
+}
+
+// StoreCustomViewIssues remembers which issues linear returned for a view
+// whose filter couldn't be translated to a local query.
+func (s *Store) StoreCustomViewIssues(viewID string, issues []Issue, reset bool) error {
+	err := s.StoreIssues(issues)
+	if err != nil {
+		return fmt.Errorf("couldn't store custom view issues: %w", err)
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start store custom view issues tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	if reset {
+		_, err = tx.Exec("DELETE FROM custom_view_issue WHERE custom_view_id = ?", viewID)
+		if err != nil {
+			return fmt.Errorf("couldn't reset custom view issues: %w", err)
+		}
+	}
+
+	type viewIssue struct {
+		CustomViewID string
+		IssueID      string
+	}
+
+	var viewIssues []viewIssue
+	for _, issue := range issues {
+		viewIssues = append(viewIssues, viewIssue{
+			CustomViewID: viewID,
+			IssueID:      issue.ID,
+		})
+	}
+
+	if len(viewIssues) > 0 {
+		_, err = tx.NamedExec(`
+			INSERT INTO custom_view_issue (custom_view_id, issue_id)
+			VALUES (:custom_view_id, :issue_id)
+			ON CONFLICT (custom_view_id, issue_id) DO NOTHING`,
+			viewIssues,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store custom view issue relations: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit store custom view issues tx: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,489 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrUntranslatableFilter = errors.New("filter can't be evaluated locally")
+
+var matchISODuration = regexp.MustCompile(`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+type filterBuilder struct {
+	args []any
+}
+
+// translateIssueFilter turns a linear IssueFilter (as found in custom views'
+// filterData) into a WHERE clause on the issues table.
+func translateIssueFilter(filterData string) (string, []any, error) {
+	var filter map[string]any
+	err := json.Unmarshal([]byte(filterData), &filter)
+	if err != nil {
+		return "", nil, fmt.Errorf("couldn't parse filter data: %w", err)
+	}
+
+	var b filterBuilder
+	where, err := b.issue(filter)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return where, b.args, nil
+}
+
+func (b *filterBuilder) issue(filter map[string]any) (string, error) {
+	var conds []string
+
+	for _, key := range sortedKeys(filter) {
+		var cond string
+		var err error
+
+		value := filter[key]
+
+		switch key {
+		case "and", "or":
+			cond, err = b.logical(key, value, b.issue)
+		case "id":
+			cond, err = b.comparator("issues.id", value)
+		case "title":
+			cond, err = b.comparator("issues.title", value)
+		case "description":
+			cond, err = b.comparator("COALESCE(issues.description, '')", value)
+		case "priority":
+			cond, err = b.comparator("issues.priority", value)
+		case "createdAt":
+			cond, err = b.comparator("issues.created_at", value)
+		case "updatedAt":
+			cond, err = b.comparator("issues.updated_at", value)
+		case "canceledAt":
+			cond, err = b.comparator("issues.canceled_at", value)
+		case "assignee":
+			cond, err = b.relation("issues.assignee_id", "users", value, b.user)
+		case "state":
+			cond, err = b.relation("issues.state_id", "states", value, b.state)
+		case "team":
+			cond, err = b.relation("issues.team_id", "teams", value, b.named)
+		case "project":
+			cond, err = b.project(value)
+		case "projectMilestone":
+			cond, err = b.relation("issues.project_milestone_id", "project_milestones", value, b.named)
+		case "labels":
+			cond, err = b.labels(value)
+		case "hasCommits": // not part of linear's filter, see builtinCustomViews
+			cond, err = b.comparator("EXISTS (SELECT 1 FROM commits WHERE commits.issue_identifier = issues.identifier)", value)
+		default:
+			return "", fmt.Errorf("%w: unsupported field %s", ErrUntranslatableFilter, key)
+		}
+		if err != nil {
+			return "", err
+		}
+
+		conds = append(conds, cond)
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+func (b *filterBuilder) logical(op string, value any, translate func(map[string]any) (string, error)) (string, error) {
+	filters, ok := value.([]any)
+	if !ok {
+		return "", fmt.Errorf("%w: %s expects a list", ErrUntranslatableFilter, op)
+	}
+
+	var conds []string
+	for _, f := range filters {
+		filter, ok := f.(map[string]any)
+		if !ok {
+			return "", fmt.Errorf("%w: %s expects a list of filters", ErrUntranslatableFilter, op)
+		}
+
+		cond, err := translate(filter)
+		if err != nil {
+			return "", err
+		}
+
+		conds = append(conds, "("+cond+")")
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return "(" + strings.Join(conds, " "+strings.ToUpper(op)+" ") + ")", nil
+}
+
+func (b *filterBuilder) relation(column, table string, value any, translate func(map[string]any) (string, error)) (string, error) {
+	filter, ok := value.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid filter for %s", ErrUntranslatableFilter, column)
+	}
+
+	var conds []string
+
+	if null, ok := filter["null"]; ok {
+		cond := column + " IS NULL"
+		if null == false {
+			cond = column + " IS NOT NULL"
+		}
+		conds = append(conds, cond)
+		delete(filter, "null")
+	}
+
+	if len(filter) > 0 {
+		cond, err := translate(filter)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, fmt.Sprintf("%s IN (SELECT id FROM %s WHERE %s)", column, table, cond))
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+func (b *filterBuilder) user(filter map[string]any) (string, error) {
+	return b.fields(filter, b.user, map[string]string{
+		"id":          "id",
+		"name":        "name",
+		"displayName": "display_name",
+		"email":       "email",
+		"isMe":        "is_me",
+	})
+}
+
+func (b *filterBuilder) state(filter map[string]any) (string, error) {
+	return b.fields(filter, b.state, map[string]string{
+		"id":   "id",
+		"name": "name",
+		"type": "type",
+	})
+}
+
+func (b *filterBuilder) named(filter map[string]any) (string, error) {
+	return b.fields(filter, b.named, map[string]string{
+		"id":   "id",
+		"name": "name",
+	})
+}
+
+func (b *filterBuilder) fields(filter map[string]any, self func(map[string]any) (string, error), columns map[string]string) (string, error) {
+	var conds []string
+
+	for _, key := range sortedKeys(filter) {
+		var cond string
+		var err error
+
+		switch key {
+		case "and", "or":
+			cond, err = b.logical(key, filter[key], self)
+		default:
+			column, ok := columns[key]
+			if !ok {
+				return "", fmt.Errorf("%w: unsupported field %s", ErrUntranslatableFilter, key)
+			}
+			cond, err = b.comparator(column, filter[key])
+		}
+		if err != nil {
+			return "", err
+		}
+
+		conds = append(conds, cond)
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+func (b *filterBuilder) project(value any) (string, error) {
+	filter, ok := value.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid project filter", ErrUntranslatableFilter)
+	}
+
+	var conds []string
+
+	// issues without a project point to the org's placeholder project
+	if null, ok := filter["null"]; ok {
+		cond := "issues.project_id LIKE 'empty-project-%'"
+		if null == false {
+			cond = "issues.project_id NOT LIKE 'empty-project-%'"
+		}
+		conds = append(conds, cond)
+		delete(filter, "null")
+	}
+
+	if len(filter) > 0 {
+		cond, err := b.named(filter)
+		if err != nil {
+			return "", err
+		}
+		conds = append(conds, fmt.Sprintf("issues.project_id IN (SELECT id FROM projects WHERE %s)", cond))
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+func (b *filterBuilder) labels(value any) (string, error) {
+	filter, ok := value.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid labels filter", ErrUntranslatableFilter)
+	}
+
+	const labelsOfIssue = `
+		SELECT 1 FROM issue_label
+		JOIN labels ON labels.id = issue_label.label_id
+		WHERE issue_label.issue_id = issues.id`
+
+	var conds []string
+
+	for _, key := range sortedKeys(filter) {
+		switch key {
+		case "some", "every", "none":
+			sub, ok := filter[key].(map[string]any)
+			if !ok {
+				return "", fmt.Errorf("%w: invalid labels filter", ErrUntranslatableFilter)
+			}
+
+			cond, err := b.fields(sub, b.named, map[string]string{
+				"id":   "labels.id",
+				"name": "labels.name",
+			})
+			if err != nil {
+				return "", err
+			}
+
+			switch key {
+			case "some":
+				conds = append(conds, fmt.Sprintf("EXISTS (%s AND %s)", labelsOfIssue, cond))
+			case "none":
+				conds = append(conds, fmt.Sprintf("NOT EXISTS (%s AND %s)", labelsOfIssue, cond))
+			case "every":
+				conds = append(conds, fmt.Sprintf("NOT EXISTS (%s AND NOT (%s))", labelsOfIssue, cond))
+			}
+		case "id", "name":
+			cond, err := b.comparator("labels."+key, filter[key])
+			if err != nil {
+				return "", err
+			}
+			conds = append(conds, fmt.Sprintf("EXISTS (%s AND %s)", labelsOfIssue, cond))
+		default:
+			return "", fmt.Errorf("%w: unsupported labels field %s", ErrUntranslatableFilter, key)
+		}
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+func (b *filterBuilder) comparator(column string, value any) (string, error) {
+	comparators, ok := value.(map[string]any)
+	if !ok {
+		return "", fmt.Errorf("%w: invalid comparator for %s", ErrUntranslatableFilter, column)
+	}
+
+	var conds []string
+
+	for _, op := range sortedKeys(comparators) {
+		operand, err := b.operand(column, comparators[op])
+		if err != nil {
+			return "", err
+		}
+
+		switch op {
+		case "eq":
+			conds = append(conds, b.bind(column+" = ?", operand))
+		case "neq":
+			conds = append(conds, b.bind(column+" IS NOT ?", operand))
+		case "eqIgnoreCase":
+			conds = append(conds, b.bind("LOWER("+column+") = LOWER(?)", operand))
+		case "neqIgnoreCase":
+			conds = append(conds, b.bind("LOWER("+column+") IS NOT LOWER(?)", operand))
+		case "lt":
+			conds = append(conds, b.bind(column+" < ?", operand))
+		case "lte":
+			conds = append(conds, b.bind(column+" <= ?", operand))
+		case "gt":
+			conds = append(conds, b.bind(column+" > ?", operand))
+		case "gte":
+			conds = append(conds, b.bind(column+" >= ?", operand))
+		case "null":
+			if operand == true {
+				conds = append(conds, column+" IS NULL")
+			} else {
+				conds = append(conds, column+" IS NOT NULL")
+			}
+		case "in", "nin":
+			values, ok := operand.([]any)
+			if !ok {
+				return "", fmt.Errorf("%w: %s expects a list", ErrUntranslatableFilter, op)
+			}
+			if len(values) == 0 {
+				if op == "in" {
+					conds = append(conds, "FALSE")
+				}
+				continue
+			}
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(values)), ", ")
+			if op == "in" {
+				conds = append(conds, b.bind(column+" IN ("+placeholders+")", values...))
+			} else {
+				conds = append(conds, b.bind("("+column+" IS NULL OR "+column+" NOT IN ("+placeholders+"))", values...))
+			}
+		case "contains":
+			conds = append(conds, b.glob(column, operand, "*", "*", false))
+		case "containsIgnoreCase":
+			conds = append(conds, b.like(column, operand, "%", "%", false))
+		case "notContains":
+			conds = append(conds, b.glob(column, operand, "*", "*", true))
+		case "notContainsIgnoreCase":
+			conds = append(conds, b.like(column, operand, "%", "%", true))
+		case "startsWith":
+			conds = append(conds, b.glob(column, operand, "", "*", false))
+		case "startsWithIgnoreCase":
+			conds = append(conds, b.like(column, operand, "", "%", false))
+		case "notStartsWith":
+			conds = append(conds, b.glob(column, operand, "", "*", true))
+		case "endsWith":
+			conds = append(conds, b.glob(column, operand, "*", "", false))
+		case "notEndsWith":
+			conds = append(conds, b.glob(column, operand, "*", "", true))
+		default:
+			return "", fmt.Errorf("%w: unsupported comparator %s", ErrUntranslatableFilter, op)
+		}
+	}
+
+	if len(conds) == 0 {
+		return "TRUE", nil
+	}
+
+	return strings.Join(conds, " AND "), nil
+}
+
+// operand converts json values into what sqlite has stored for the column,
+// dates are stored as utc timestamps and can be given as relative durations.
+func (b *filterBuilder) operand(column string, value any) (any, error) {
+	if list, ok := value.([]any); ok {
+		var res []any
+		for _, v := range list {
+			operand, err := b.operand(column, v)
+			if err != nil {
+				return nil, err
+			}
+			res = append(res, operand)
+		}
+		return res, nil
+	}
+
+	switch v := value.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v), nil
+		}
+		return v, nil
+	case string:
+		if !strings.HasSuffix(column, "_at") {
+			return v, nil
+		}
+		if t, ok := parseISODuration(v); ok {
+			return t, nil
+		}
+		for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+			t, err := time.Parse(layout, v)
+			if err == nil {
+				return t.UTC(), nil
+			}
+		}
+		return nil, fmt.Errorf("%w: invalid date %s", ErrUntranslatableFilter, v)
+	case bool, nil:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("%w: unsupported value %v", ErrUntranslatableFilter, v)
+	}
+}
+
+func (b *filterBuilder) like(column string, operand any, prefix, suffix string, negate bool) string {
+	s, _ := operand.(string)
+	s = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+
+	not := ""
+	if negate {
+		not = "NOT "
+	}
+
+	return b.bind(fmt.Sprintf(`COALESCE(%s, '') %sLIKE ? ESCAPE '\'`, column, not), prefix+s+suffix)
+}
+
+// glob matches like linear does without IgnoreCase, sqlite's LIKE ignores
+// the case of ascii letters.
+func (b *filterBuilder) glob(column string, operand any, prefix, suffix string, negate bool) string {
+	s, _ := operand.(string)
+	s = strings.NewReplacer(`[`, `[[]`, `*`, `[*]`, `?`, `[?]`).Replace(s)
+
+	not := ""
+	if negate {
+		not = "NOT "
+	}
+
+	return b.bind(fmt.Sprintf(`COALESCE(%s, '') %sGLOB ?`, column, not), prefix+s+suffix)
+}
+
+func (b *filterBuilder) bind(cond string, args ...any) string {
+	b.args = append(b.args, args...)
+	return cond
+}
+
+func parseISODuration(s string) (time.Time, bool) {
+	match := matchISODuration.FindStringSubmatch(s)
+	if match == nil || s == "P" || s == "-P" {
+		return time.Time{}, false
+	}
+
+	n := func(i int) int {
+		v, _ := strconv.Atoi(match[i])
+		return v
+	}
+
+	sign := 1
+	if match[1] == "-" {
+		sign = -1
+	}
+
+	t := time.Now().UTC().AddDate(sign*n(2), sign*n(3), sign*(n(4)*7+n(5)))
+	t = t.Add(time.Duration(sign) * (time.Duration(n(6))*time.Hour +
+		time.Duration(n(7))*time.Minute +
+		time.Duration(n(8))*time.Second))
+
+	return t, true
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+)
+
+func TestTranslateIssueFilter(t *testing.T) {
+	s := newTestStore(t)
+
+	tests := []struct {
+		filter string
+		issues []string
+	}{
+		// titles are "fix: login crash", "feat: export" and "Crash on save"
+		{filter: `{"title": {"contains": "crash"}}`, issues: []string{"ENG-1"}},
+		{filter: `{"title": {"containsIgnoreCase": "crash"}}`, issues: []string{"ENG-1", "ENG-3"}},
+		{filter: `{"title": {"notContains": "crash"}}`, issues: []string{"ENG-2", "ENG-3"}},
+		{filter: `{"title": {"notContainsIgnoreCase": "crash"}}`, issues: []string{"ENG-2"}},
+		{filter: `{"title": {"startsWith": "crash"}}`, issues: nil},
+		{filter: `{"title": {"startsWith": "Crash"}}`, issues: []string{"ENG-3"}},
+		{filter: `{"title": {"startsWithIgnoreCase": "crash"}}`, issues: []string{"ENG-3"}},
+		{filter: `{"title": {"notStartsWith": "f"}}`, issues: []string{"ENG-3"}},
+		{filter: `{"title": {"endsWith": "Save"}}`, issues: nil},
+		{filter: `{"title": {"endsWith": "save"}}`, issues: []string{"ENG-3"}},
+		{filter: `{"title": {"notEndsWith": "crash"}}`, issues: []string{"ENG-2", "ENG-3"}},
+		{filter: `{"title": {"eq": "feat: export"}}`, issues: []string{"ENG-2"}},
+		{filter: `{"title": {"eqIgnoreCase": "FEAT: EXPORT"}}`, issues: []string{"ENG-2"}},
+		// wildcards of GLOB and LIKE are matched as they are
+		{filter: `{"title": {"contains": "*"}}`, issues: nil},
+		{filter: `{"title": {"contains": "?"}}`, issues: nil},
+		{filter: `{"title": {"containsIgnoreCase": "_"}}`, issues: nil},
+		{filter: `{"description": {"notContains": "crash"}}`, issues: []string{"ENG-1", "ENG-2", "ENG-3"}},
+		{filter: `{"priority": {"lte": 3, "gt": 0}}`, issues: []string{"ENG-1", "ENG-2"}},
+		{filter: `{"assignee": {"null": true}}`, issues: []string{"ENG-3"}},
+		{filter: `{"assignee": {"isMe": {"eq": true}}}`, issues: []string{"ENG-1"}},
+		{filter: `{"state": {"type": {"in": ["started", "completed"]}}}`, issues: []string{"ENG-1", "ENG-3"}},
+		{filter: `{"project": {"null": true}}`, issues: []string{"ENG-2", "ENG-3"}},
+		{filter: `{"labels": {"name": {"eq": "UI"}}}`, issues: []string{"ENG-3"}},
+		{filter: `{"labels": {"none": {"name": {"eq": "Bug"}}}}`, issues: []string{"ENG-2"}},
+		{filter: `{"createdAt": {"lt": "-P1M"}}`, issues: []string{"ENG-2", "ENG-3"}},
+		{filter: `{"or": [{"title": {"startsWith": "feat"}}, {"labels": {"name": {"eq": "UI"}}}]}`, issues: []string{"ENG-2", "ENG-3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.filter, func(t *testing.T) {
+			where, args, err := translateIssueFilter(test.filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			err = s.db.Select(&got, fmt.Sprintf("SELECT identifier FROM issues WHERE %s", where), args...)
+			if err != nil {
+				t.Fatalf("%s: %v", where, err)
+			}
+			slices.Sort(got)
+
+			if !slices.Equal(got, test.issues) {
+				t.Errorf("expected %v, got %v for %s %v", test.issues, got, where, args)
+			}
+		})
+	}
+}
+
+func TestTranslateIssueFilterUnsupported(t *testing.T) {
+	for _, filter := range []string{
+		`{"title": {"containsIgnoreCaseAndAccent": "crash"}}`,
+		`{"estimate": {"eq": 3}}`,
+		`{"assignee": {"teams": {"name": {"eq": "ENG"}}}}`,
+		`{"and": {"title": {"eq": "x"}}}`,
+	} {
+		_, _, err := translateIssueFilter(filter)
+		if !errors.Is(err, ErrUntranslatableFilter) {
+			t.Errorf("expected %s to be untranslatable, got %v", filter, err)
+		}
+	}
+}
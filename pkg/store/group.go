@@ -0,0 +1,90 @@
+package store
+
+import (
+	"fmt"
+)
+
+var groupModeNames = []string{"none", "state", "assignee", "project", "prio", "team", "label"}
+
+func (m GroupMode) String() string {
+	if int(m) < 0 || int(m) >= len(groupModeNames) {
+		return groupModeNames[GroupModeNone]
+	}
+	return groupModeNames[m]
+}
+
+// SetGroupMode groups issues by mode ahead of their sort, choosing the mode
+// in use again stops grouping.
+func (s *Store) SetGroupMode(mode GroupMode) error {
+	if s.current.Org.GroupMode == mode {
+		mode = GroupModeNone
+	}
+	s.current.Org.GroupMode = mode
+
+	_, err := s.db.Exec(`
+		UPDATE orgs
+		SET group_mode = ?
+		WHERE orgs.active = TRUE;`,
+		mode,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save group mode: %w", err)
+	}
+	return nil
+}
+
+// getGrouper selects the group of issues as group.key, group.name and
+// group.color, joining what it needs, and orders issues by it. The order
+// leads the sort so issues are sorted within their group.
+func (s *Store) getGrouper() (columns, join, order string) {
+	group := func(key, name, color string) string {
+		return fmt.Sprintf(`%s AS "group.key", %s AS "group.name", %s AS "group.color"`, key, name, color)
+	}
+
+	switch s.current.Org.GroupMode {
+	case GroupModeState:
+		return group("states.name", "states.name", "states.color"), "", `
+			CASE states.type
+				WHEN 'triage' THEN 0
+				WHEN 'backlog' THEN 1
+				WHEN 'unstarted' THEN 2
+				WHEN 'started' THEN 3
+				WHEN 'completed' THEN 4
+				WHEN 'canceled' THEN 5
+				ELSE 6
+			END ASC, states.name ASC,`
+	case GroupModeAssignee:
+		return group("COALESCE(users.id, '')", "COALESCE(users.display_name, 'No assignee')", "''"), "",
+			"users.id IS NULL ASC, users.is_me DESC, users.display_name ASC,"
+	case GroupModeProject:
+		return group("issues.project_id", "COALESCE(projects.name, '')", "COALESCE(projects.color, '')"), "",
+			fmt.Sprintf("issues.project_id = '%s' ASC, projects.name ASC,", getEmptyProjectID(s.current.Org.ID))
+	case GroupModePrio:
+		return group("CAST(issues.priority AS TEXT)", `
+			CASE issues.priority
+				WHEN 1 THEN 'Urgent'
+				WHEN 2 THEN 'High'
+				WHEN 3 THEN 'Medium'
+				WHEN 4 THEN 'Low'
+				ELSE 'No Priority'
+			END`, "''"), "",
+			"issues.priority = 0 ASC, issues.priority ASC,"
+	case GroupModeTeam:
+		return group("teams.id", "teams.name", "teams.color"), "", "teams.name ASC,"
+	case GroupModeLabel:
+		// NOTE: issues fall in the group of their first label by name only,
+		// listing them under each label would show an issue on several rows
+		// of the table, which keys its rows by the issue
+		return group("COALESCE(group_labels.name, '')", "COALESCE(group_labels.name, 'No label')", "COALESCE(group_labels.color, '')"), `
+			LEFT JOIN labels AS group_labels ON group_labels.id = (
+				SELECT labels.id FROM issue_label
+				JOIN labels ON labels.id = issue_label.label_id
+				WHERE issue_label.issue_id = issues.id
+				ORDER BY labels.name
+				LIMIT 1
+			)`,
+			"group_labels.name IS NULL ASC, group_labels.name ASC,"
+	default:
+		return group("''", "''", "''"), "", ""
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE issues ADD COLUMN archived_at TIMESTAMP;
+ALTER TABLE issues ADD COLUMN trashed BOOL NOT NULL DEFAULT FALSE;
@@ -0,0 +1,10 @@
+CREATE TABLE saved_filters (
+    name TEXT NOT NULL,
+    query TEXT NOT NULL,
+    sort_mode INTEGER NOT NULL DEFAULT 0,
+    descending BOOLEAN NOT NULL DEFAULT FALSE,
+    project_id TEXT,
+    org_id TEXT NOT NULL,
+    PRIMARY KEY (org_id, name),
+    FOREIGN KEY (org_id) REFERENCES orgs(id)
+);
@@ -0,0 +1,39 @@
+DROP TABLE search;
+
+CREATE VIRTUAL TABLE search USING fts5 (
+  tokenize = "unicode61 remove_diacritics 2",
+  prefix = '1 2 3',
+  id UNINDEXED,
+  title,
+  description,
+  state,
+  project,
+  team,
+  assignee,
+  labels
+);
+
+CREATE VIRTUAL TABLE search_terms USING fts5vocab(search, 'row');
+
+WITH json_labels AS (
+    SELECT issue_id, group_concat(labels.name, ' ') as labels
+    FROM issue_label
+    JOIN labels ON labels.id = issue_label.label_id
+    GROUP BY issue_id
+)
+INSERT INTO search (id, title, description, state, project, team, assignee, labels)
+SELECT issues.id,
+    title,
+    issues.description,
+    states.name,
+    projects.name,
+    teams.name,
+    users.name,
+    json_labels.labels
+FROM issues
+LEFT JOIN users ON issues.assignee_id = users.id
+LEFT JOIN projects ON issues.project_id = projects.id
+LEFT JOIN teams ON issues.team_id = teams.id
+LEFT JOIN states ON issues.state_id = states.id
+LEFT JOIN json_labels ON json_labels.issue_id = issues.id
+WHERE issues.archived_at IS NULL;
@@ -0,0 +1 @@
+ALTER TABLE issues ADD COLUMN remote BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,17 @@
+ALTER TABLE issues ADD COLUMN estimate REAL;
+ALTER TABLE issues ADD COLUMN due_date TIMESTAMP;
+ALTER TABLE issues ADD COLUMN cycle_number INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE issues ADD COLUMN cycle_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE issues ADD COLUMN creator_id TEXT;
+
+CREATE TABLE table_columns (
+    view TEXT NOT NULL,
+    position INTEGER NOT NULL,
+    name TEXT NOT NULL,
+    width REAL NOT NULL,
+    org_id TEXT NOT NULL,
+    PRIMARY KEY (org_id, view, position),
+    FOREIGN KEY (org_id) REFERENCES orgs(id)
+);
+
+UPDATE orgs SET synced_at = DATETIME('NOW', '-6 months');
@@ -0,0 +1 @@
+ALTER TABLE orgs ADD COLUMN group_mode INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE states ADD COLUMN position REAL NOT NULL DEFAULT 0;
@@ -0,0 +1,17 @@
+CREATE TABLE notifications (
+    id TEXT PRIMARY KEY NOT NULL,
+    type TEXT NOT NULL,
+    title TEXT NOT NULL,
+    subtitle TEXT NOT NULL,
+    actor_name TEXT NOT NULL,
+    issue_id TEXT,
+    issue_identifier TEXT,
+    read_at TIMESTAMP,
+    snoozed_until_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    org_id TEXT NOT NULL,
+    FOREIGN KEY (org_id) REFERENCES orgs(id)
+);
+
+CREATE INDEX idx_notifications_org_created
+ON notifications (org_id, created_at);
@@ -0,0 +1,20 @@
+ALTER TABLE states ADD COLUMN type TEXT NOT NULL DEFAULT '';
+
+CREATE TABLE custom_views (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL,
+    color TEXT NOT NULL,
+    team_id TEXT,
+    filter_data TEXT NOT NULL,
+    org_id TEXT NOT NULL,
+    FOREIGN KEY (org_id) REFERENCES orgs(id)
+);
+
+CREATE TABLE custom_view_issue (
+    custom_view_id TEXT NOT NULL,
+    issue_id TEXT NOT NULL,
+    FOREIGN KEY (custom_view_id) REFERENCES custom_views(id) ON DELETE CASCADE,
+    FOREIGN KEY (issue_id) REFERENCES issues(id) ON DELETE CASCADE,
+    UNIQUE (custom_view_id, issue_id)
+);
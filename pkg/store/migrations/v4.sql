@@ -0,0 +1,16 @@
+ALTER TABLE projects ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN state TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN lead_name TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN health TEXT NOT NULL DEFAULT '';
+ALTER TABLE projects ADD COLUMN progress REAL NOT NULL DEFAULT 0;
+ALTER TABLE projects ADD COLUMN start_date TIMESTAMP;
+ALTER TABLE projects ADD COLUMN target_date TIMESTAMP;
+
+CREATE TABLE project_milestones (
+    id TEXT PRIMARY KEY NOT NULL,
+    name TEXT NOT NULL,
+    target_date TIMESTAMP,
+    sort_order REAL NOT NULL,
+    project_id TEXT NOT NULL,
+    FOREIGN KEY (project_id) REFERENCES projects(id) ON DELETE CASCADE
+);
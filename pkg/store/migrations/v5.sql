@@ -0,0 +1,3 @@
+ALTER TABLE issues ADD COLUMN project_milestone_id TEXT;
+
+UPDATE orgs SET synced_at = DATETIME('NOW', '-6 months');
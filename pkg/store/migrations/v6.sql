@@ -0,0 +1 @@
+ALTER TABLE orgs ADD COLUMN workspace TEXT NOT NULL DEFAULT '';
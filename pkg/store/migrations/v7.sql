@@ -0,0 +1,3 @@
+ALTER TABLE issues ADD COLUMN branch_name TEXT NOT NULL DEFAULT '';
+
+UPDATE orgs SET synced_at = DATETIME('NOW', '-6 months');
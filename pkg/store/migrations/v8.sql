@@ -0,0 +1,13 @@
+CREATE TABLE commits (
+    sha TEXT NOT NULL,
+    repo TEXT NOT NULL,
+    branch TEXT NOT NULL,
+    author TEXT NOT NULL,
+    subject TEXT NOT NULL,
+    authored_at TIMESTAMP NOT NULL,
+    issue_identifier TEXT NOT NULL,
+    UNIQUE (sha, issue_identifier)
+);
+
+CREATE INDEX idx_commits_issue_identifier
+ON commits (issue_identifier);
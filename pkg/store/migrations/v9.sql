@@ -0,0 +1 @@
+ALTER TABLE orgs ADD COLUMN working_on_issue_id TEXT NOT NULL DEFAULT '';
@@ -9,27 +9,49 @@ type storeResource interface {
 }
 
 type Org struct {
-	ID        string
-	Name      string
-	URLKey    string
-	Active    bool
-	SyncedAt  time.Time
-	SortMode  SortMode
-	SortOrder sortOrder
+	ID               string
+	Name             string
+	URLKey           string
+	Workspace        string
+	WorkingOnIssueID string
+	Active           bool
+	SyncedAt         time.Time
+	SortMode         SortMode
+	SortOrder        sortOrder
+	GroupMode        GroupMode
 }
 
 type Project struct {
-	ID    string
-	Name  string
-	Color string
-	Teams []Team
+	ID          string
+	Name        string
+	Color       string
+	Description string
+	State       string
+	LeadName    string
+	Health      string
+	Progress    float64
+	StartDate   *time.Time
+	TargetDate  *time.Time
+	OpenIssues  int
+	Teams       []Team
+	Milestones  []ProjectMilestone
+}
+
+type ProjectMilestone struct {
+	ID         string
+	Name       string
+	TargetDate *time.Time
+	SortOrder  float64
+	ProjectID  string
 }
 
 type State struct {
-	ID     string
-	Name   string
-	Color  string
-	TeamID string
+	ID       string
+	Name     string
+	Color    string
+	Type     string
+	Position float64
+	TeamID   string
 }
 
 func (state *State) position(name string, pos int) int {
@@ -71,6 +93,20 @@ type User struct {
 
 type Prio int
 
+func (p Prio) String() string {
+	switch p {
+	case 1:
+		return "Urgent"
+	case 2:
+		return "High"
+	case 3:
+		return "Medium"
+	case 4:
+		return "Low"
+	}
+	return "No Priority"
+}
+
 type Label struct {
 	ID     string
 	Name   string
@@ -78,10 +114,25 @@ type Label struct {
 	TeamID string
 }
 
+// Cycle is the cycle an issue is planned in, the zero number when it isn't.
+type Cycle struct {
+	Number int
+	Name   string
+}
+
+// Group is the group an issue falls in while issues are grouped, the zero
+// group otherwise.
+type Group struct {
+	Key   string
+	Name  string
+	Color string
+}
+
 type Issue struct {
 	ID          string
 	Identifier  string
 	Title       string
+	BranchName  string
 	Description string
 	Labels      []Label
 	Priority    Prio
@@ -89,10 +140,94 @@ type Issue struct {
 	State       State
 	Assignee    User
 	Project     Project
+	Milestone   ProjectMilestone
+	Cycle       Cycle
+	Creator     User
+	Group       Group
+	Estimate    *float64
+	DueDate     *time.Time
 	Pinned      bool
+	Remote      bool
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	CanceledAt  *time.Time
+	ArchivedAt  *time.Time
+	Trashed     bool
+	Commits     []Commit
+	Match       *SearchMatch
+}
+
+// SearchMatch is set on issues returned by SearchIssues.
+type SearchMatch struct {
+	// Terms are the lowercased words, or beginnings of words, the search
+	// matched on, typo corrections included.
+	Terms []string
+	// Snippet is the part of the description around the match, empty when
+	// the description didn't match.
+	Snippet string
+}
+
+// Commit is a local git commit mentioning an issue in its message or branch.
+type Commit struct {
+	SHA             string
+	Repo            string
+	Branch          string
+	Author          string
+	Subject         string
+	AuthoredAt      time.Time
+	IssueIdentifier string
+}
+
+// Comment is a comment on an issue, fetched when the issue is shown in
+// detail rather than synced.
+type Comment struct {
+	ID        string
+	Author    string
+	Body      string
+	CreatedAt time.Time
+}
+
+// Change is a field of an issue changed by someone, like its state going
+// from Todo to In Progress. Like comments they're fetched, not synced.
+type Change struct {
+	Actor     string
+	Field     string
+	From      string
+	To        string
+	CreatedAt time.Time
+}
+
+type CustomView struct {
+	ID          string
+	Name        string
+	Description string
+	Color       string
+	TeamID      string
+	FilterData  string
+}
+
+// Local reports whether the view's filter can be evaluated against the
+// local store, otherwise its issues have to be queried from linear.
+func (v CustomView) Local() bool {
+	_, _, err := translateIssueFilter(v.FilterData)
+	return err == nil
+}
+
+type Notification struct {
+	ID              string
+	Type            string
+	Title           string
+	Subtitle        string
+	ActorName       string
+	IssueID         string
+	IssueIdentifier string
+	ReadAt          *time.Time
+	SnoozedUntilAt  *time.Time
+	CreatedAt       time.Time
+}
+
+func (n Notification) Unread() bool {
+	return n.ReadAt == nil
 }
 
 func (u Org) getID() string     { return u.ID }
@@ -102,3 +237,8 @@ func (u Project) getID() string { return u.ID }
 func (u State) getID() string   { return u.ID }
 func (u Issue) getID() string   { return u.ID }
 func (u Label) getID() string   { return u.ID }
+
+func (u Notification) getID() string     { return u.ID }
+func (u CustomView) getID() string       { return u.ID }
+func (u ProjectMilestone) getID() string { return u.ID }
+func (u Commit) getID() string           { return u.SHA + ":" + u.IssueIdentifier }
@@ -0,0 +1,172 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+func (s *Store) Notifications() ([]Notification, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var notifications []Notification
+	err := s.db.Select(&notifications, fmt.Sprintf(`
+		SELECT id, type, title, subtitle, actor_name,
+			COALESCE(issue_id, '') AS issue_id,
+			COALESCE(issue_identifier, '') AS issue_identifier,
+			read_at, snoozed_until_at, created_at
+		FROM notifications
+		WHERE org_id = %s AND (
+			snoozed_until_at IS NULL OR
+			snoozed_until_at <= ?
+		)
+		ORDER BY read_at IS NULL DESC, created_at DESC`, currentOrg),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select notifications: %w", err)
+	}
+
+	return notifications, nil
+}
+
+func (s *Store) Notification(notificationID string) (*Notification, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var notification Notification
+	err := s.db.Get(&notification, fmt.Sprintf(`
+		SELECT id, type, title, subtitle, actor_name,
+			COALESCE(issue_id, '') AS issue_id,
+			COALESCE(issue_identifier, '') AS issue_identifier,
+			read_at, snoozed_until_at, created_at
+		FROM notifications
+		WHERE id = ? AND org_id = %s`, currentOrg),
+		notificationID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select notification: %w", err)
+	}
+
+	return &notification, nil
+}
+
+func (s *Store) UnreadNotifications() (int, error) {
+	if s.current.Org.ID == "" {
+		return 0, ErrNoOrgSelected
+	}
+
+	var count int
+	err := s.db.Get(&count, fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM notifications
+		WHERE org_id = %s AND read_at IS NULL AND (
+			snoozed_until_at IS NULL OR
+			snoozed_until_at <= ?
+		)`, currentOrg),
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("couldn't count unread notifications: %w", err)
+	}
+
+	return count, nil
+}
+
+func (s *Store) StoreNotifications(notifications []Notification) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	notifications = removeDuplicatesAndEmpties(notifications)
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	_, err := s.db.NamedExec(fmt.Sprintf(`
+		INSERT INTO notifications (
+			id, type, title, subtitle, actor_name,
+			issue_id, issue_identifier, read_at,
+			snoozed_until_at, created_at, org_id
+		)
+		VALUES (
+			:id, :type, :title, :subtitle, :actor_name,
+			NULLIF(:issue_id, ''), NULLIF(:issue_identifier, ''), :read_at,
+			:snoozed_until_at, :created_at, %s
+		)
+		ON CONFLICT (id) DO UPDATE
+		SET type = EXCLUDED.type,
+			title = EXCLUDED.title,
+			subtitle = EXCLUDED.subtitle,
+			actor_name = EXCLUDED.actor_name,
+			issue_id = EXCLUDED.issue_id,
+			issue_identifier = EXCLUDED.issue_identifier,
+			read_at = EXCLUDED.read_at,
+			snoozed_until_at = EXCLUDED.snoozed_until_at
+		`, currentOrg),
+		notifications,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't store notifications: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SetNotificationsRead(read bool, notificationIDs ...string) error {
+	if len(notificationIDs) == 0 {
+		return nil
+	}
+
+	var readAt *time.Time
+	if read {
+		now := time.Now().UTC()
+		readAt = &now
+	}
+
+	query, args, err := sqlx.In(`
+		UPDATE notifications
+		SET read_at = ?
+		WHERE id IN (?)`,
+		readAt,
+		notificationIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't generate mark notifications read query: %w", err)
+	}
+
+	_, err = s.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't mark notifications read: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) SnoozeNotifications(until time.Time, notificationIDs ...string) error {
+	if len(notificationIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`
+		UPDATE notifications
+		SET snoozed_until_at = ?
+		WHERE id IN (?)`,
+		until.UTC(),
+		notificationIDs,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't generate snooze notifications query: %w", err)
+	}
+
+	_, err = s.db.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't snooze notifications: %w", err)
+	}
+
+	return nil
+}
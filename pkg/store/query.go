@@ -0,0 +1,360 @@
+package store
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	matchQueryKey      = regexp.MustCompile(`^-?([a-z]+):`)
+	matchQueryDuration = regexp.MustCompile(`^(\d+)(h|d|w|mo|y)$`)
+)
+
+var queryPrios = []string{"none", "urgent", "high", "medium", "low"}
+
+// queryKeys are the filters of a query, any other word ending in a colon is
+// searched for like titles such as "fix: login crash" have them.
+var queryKeys = []string{
+	"assignee", "state", "label", "team", "project", "milestone",
+	"prio", "priority", "age", "updated", "has", "is",
+}
+
+// QueryError points at the part of a filter query that couldn't be parsed.
+type QueryError struct {
+	Pos int
+	Msg string
+}
+
+func (e *QueryError) Error() string {
+	return e.Msg
+}
+
+// Query is a parsed filter query, like
+// `assignee:me state:"In Progress",Todo prio:<=2 -label:wontfix crash`.
+// Filters narrow the issues down, the remaining words are searched for.
+type Query struct {
+	Text  string
+	where []string
+	args  []any
+}
+
+type queryToken struct {
+	pos  int
+	text string
+}
+
+func tokenizeQuery(q string) ([]queryToken, error) {
+	var tokens []queryToken
+
+	start := -1
+	quote := -1
+
+	for i, r := range q {
+		switch {
+		case r == '"':
+			if start == -1 {
+				start = i
+			}
+			if quote == -1 {
+				quote = i
+			} else {
+				quote = -1
+			}
+		case r == ' ' && quote == -1:
+			if start != -1 {
+				tokens = append(tokens, queryToken{pos: start, text: q[start:i]})
+				start = -1
+			}
+		default:
+			if start == -1 {
+				start = i
+			}
+		}
+	}
+
+	if quote != -1 {
+		return nil, &QueryError{Pos: quote, Msg: "unterminated quote"}
+	}
+
+	if start != -1 {
+		tokens = append(tokens, queryToken{pos: start, text: q[start:]})
+	}
+
+	return tokens, nil
+}
+
+// splitQueryValues splits comma separated values, commas inside quotes
+// included in the value.
+func splitQueryValues(s string) []string {
+	var values []string
+	var value strings.Builder
+	var quoted bool
+
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case r == ',' && !quoted:
+			values = append(values, value.String())
+			value.Reset()
+		default:
+			value.WriteRune(r)
+		}
+	}
+
+	return append(values, value.String())
+}
+
+func ParseQuery(q string) (*Query, error) {
+	tokens, err := tokenizeQuery(q)
+	if err != nil {
+		return nil, err
+	}
+
+	query := &Query{}
+	var words []string
+
+	for _, token := range tokens {
+		match := matchQueryKey.FindStringSubmatch(token.text)
+		if match == nil || !slices.Contains(queryKeys, match[1]) {
+			words = append(words, strings.ReplaceAll(token.text, `"`, ""))
+			continue
+		}
+
+		negated := strings.HasPrefix(token.text, "-")
+		key := match[1]
+		raw := token.text[len(match[0]):]
+		valuePos := token.pos + len(match[0])
+
+		if raw == "" {
+			return nil, &QueryError{Pos: valuePos, Msg: fmt.Sprintf("%s needs a value", key)}
+		}
+
+		cond, args, err := queryCondition(key, raw)
+		if err != nil {
+			return nil, &QueryError{Pos: valuePos, Msg: err.Error()}
+		}
+
+		// NOTE: conditions on a missing assignee or project are NULL, negated they still should match
+		if negated {
+			cond = fmt.Sprintf("NOT COALESCE(%s, FALSE)", cond)
+		}
+
+		query.where = append(query.where, cond)
+		query.args = append(query.args, args...)
+	}
+
+	query.Text = strings.Join(words, " ")
+
+	return query, nil
+}
+
+func lowerValues(raw string) ([]any, error) {
+	var args []any
+	for _, value := range splitQueryValues(raw) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			return nil, fmt.Errorf("empty value in %s", raw)
+		}
+		args = append(args, strings.ToLower(value))
+	}
+	return args, nil
+}
+
+// anyOf ORs the condition once for every value, each occurrence of ? is
+// bound to the value.
+func anyOf(cond string, values []any) (string, []any) {
+	n := strings.Count(cond, "?")
+
+	var conds []string
+	var args []any
+	for _, value := range values {
+		conds = append(conds, cond)
+		for range n {
+			args = append(args, value)
+		}
+	}
+
+	return "(" + strings.Join(conds, " OR ") + ")", args
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", n), ", ")
+}
+
+func splitComparator(raw string) (string, string) {
+	for _, op := range []string{"<=", ">=", "<", ">", "="} {
+		if strings.HasPrefix(raw, op) {
+			return op, raw[len(op):]
+		}
+	}
+	return "", raw
+}
+
+func queryCondition(key, raw string) (string, []any, error) {
+	switch key {
+	case "assignee":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var conds []string
+		var args []any
+		for _, value := range values {
+			switch value {
+			case "me":
+				conds = append(conds, "users.is_me = TRUE")
+			case "none":
+				conds = append(conds, "issues.assignee_id IS NULL")
+			default:
+				conds = append(conds, "(LOWER(users.display_name) = ? OR LOWER(users.name) = ? OR LOWER(users.email) = ?)")
+				args = append(args, value, value, value)
+			}
+		}
+		return "(" + strings.Join(conds, " OR ") + ")", args, nil
+
+	case "state":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		// NOTE: state types like started match every state of that type
+		cond, args := anyOf("(LOWER(states.name) = ? OR states.type = ?)", values)
+		return cond, args, nil
+
+	case "label":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM issue_label
+			JOIN labels ON labels.id = issue_label.label_id
+			WHERE issue_label.issue_id = issues.id AND LOWER(labels.name) IN (%s)
+		)`, placeholders(len(values))), values, nil
+
+	case "team":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		// NOTE: keys aren't synced, they're matched on the identifiers instead
+		cond, args := anyOf("(LOWER(teams.name) = ? OR issues.identifier LIKE ? || '-%')", values)
+		return cond, args, nil
+
+	case "project":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("LOWER(projects.name) IN (%s)", placeholders(len(values))), values, nil
+
+	case "milestone":
+		values, err := lowerValues(raw)
+		if err != nil {
+			return "", nil, err
+		}
+		return fmt.Sprintf("LOWER(project_milestones.name) IN (%s)", placeholders(len(values))), values, nil
+
+	case "prio", "priority":
+		op, rest := splitComparator(raw)
+
+		values, err := lowerValues(rest)
+		if err != nil {
+			return "", nil, err
+		}
+
+		var prios []any
+		for _, value := range values {
+			prio := slices.Index(queryPrios, value.(string))
+			if n, err := strconv.Atoi(value.(string)); err == nil && n >= 0 && n < len(queryPrios) {
+				prio = n
+			}
+			if prio == -1 {
+				return "", nil, fmt.Errorf("unknown priority %s, use 0-4 or %s", value, strings.Join(queryPrios, ", "))
+			}
+			prios = append(prios, prio)
+		}
+
+		if op == "" || op == "=" {
+			return fmt.Sprintf("issues.priority IN (%s)", placeholders(len(prios))), prios, nil
+		}
+		if len(prios) > 1 {
+			return "", nil, fmt.Errorf("%s can't be compared to a list", key)
+		}
+
+		// NOTE: no priority is 0, yet it ranks below low
+		return fmt.Sprintf("(issues.priority != 0 AND issues.priority %s ?)", op), prios, nil
+
+	case "age", "updated":
+		column := "issues.created_at"
+		if key == "updated" {
+			column = "issues.updated_at"
+		}
+
+		op, rest := splitComparator(raw)
+		if op == "" || op == "=" {
+			return "", nil, fmt.Errorf("%s needs <, <=, > or >=, like %s:>30d", key, key)
+		}
+
+		match := matchQueryDuration.FindStringSubmatch(rest)
+		if match == nil {
+			return "", nil, fmt.Errorf("invalid duration %s, use h, d, w, mo or y like 30d", rest)
+		}
+
+		n, _ := strconv.Atoi(match[1])
+		var modifier string
+		switch match[2] {
+		case "h":
+			modifier = fmt.Sprintf("-%d hours", n)
+		case "d":
+			modifier = fmt.Sprintf("-%d days", n)
+		case "w":
+			modifier = fmt.Sprintf("-%d days", n*7)
+		case "mo":
+			modifier = fmt.Sprintf("-%d months", n)
+		case "y":
+			modifier = fmt.Sprintf("-%d years", n)
+		}
+
+		// older than the duration means created before that point in time
+		flipped := map[string]string{"<": ">", "<=": ">=", ">": "<", ">=": "<="}[op]
+
+		return fmt.Sprintf("%s %s DATETIME(CURRENT_TIMESTAMP, ?)", column, flipped), []any{modifier}, nil
+
+	case "has":
+		var conds []string
+		for _, value := range splitQueryValues(raw) {
+			switch strings.ToLower(value) {
+			case "project":
+				conds = append(conds, "(issues.project_id IS NOT NULL AND issues.project_id NOT LIKE 'empty-project-%')")
+			case "assignee":
+				conds = append(conds, "issues.assignee_id IS NOT NULL")
+			case "label", "labels":
+				conds = append(conds, "EXISTS (SELECT 1 FROM issue_label WHERE issue_label.issue_id = issues.id)")
+			case "milestone":
+				conds = append(conds, "issues.project_milestone_id IS NOT NULL")
+			case "description":
+				conds = append(conds, "COALESCE(issues.description, '') != ''")
+			case "commits":
+				conds = append(conds, "EXISTS (SELECT 1 FROM commits WHERE commits.issue_identifier = issues.identifier)")
+			default:
+				return "", nil, fmt.Errorf("unknown has:%s, use project, assignee, labels, milestone, description or commits", value)
+			}
+		}
+		return "(" + strings.Join(conds, " OR ") + ")", nil, nil
+
+	case "is":
+		switch strings.ToLower(raw) {
+		case "pinned":
+			return "issues.pinned = TRUE", nil, nil
+		}
+		return "", nil, fmt.Errorf("unknown is:%s, use pinned", raw)
+	}
+
+	return "", nil, fmt.Errorf("unknown filter %s", key)
+}
@@ -0,0 +1,231 @@
+package store
+
+import (
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+)
+
+// newTestStore has three issues of a team:
+//
+//	ENG-1 "fix: login crash" assigned to me, In Progress, urgent, bug, Web, 2 days old
+//	ENG-2 "feat: export" assigned to ana, Todo, medium, no labels, 60 days old
+//	ENG-3 "Crash on save" unassigned, Shipped, no priority, bug and ui, 400 days old
+//
+// Shipped is a completed state, issues done long ago are only hidden when
+// they're in Done or Canceled.
+func newTestStore(t *testing.T) *Store {
+	t.Helper()
+
+	s, err := New(filepath.Join(t.TempDir(), "tinear.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	_, err = s.StoreOrg(Org{ID: "0b9f5c2e-org", Name: "Org", URLKey: "org"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	team := Team{ID: "team", Name: "Engineering", Color: "#5e6ad2"}
+	_, err = s.StoreTeams([]Team{team})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	me := User{ID: "me", Name: "Me", DisplayName: "me", Email: "me@example.com", IsMe: true}
+	ana := User{ID: "ana", Name: "Ana Lima", DisplayName: "ana", Email: "ana@example.com"}
+	err = s.StoreUsers([]User{me, ana})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	todo := State{ID: "todo", Name: "Todo", Color: "#e2e2e2", Type: "unstarted", TeamID: team.ID}
+	started := State{ID: "started", Name: "In Progress", Color: "#f2c94c", Type: "started", TeamID: team.ID}
+	done := State{ID: "shipped", Name: "Shipped", Color: "#5e6ad2", Type: "completed", TeamID: team.ID}
+	err = s.StoreStates([]State{todo, started, done})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bug := Label{ID: "bug", Name: "Bug", Color: "#eb5757", TeamID: team.ID}
+	ui := Label{ID: "ui", Name: "UI", Color: "#bb87fc", TeamID: team.ID}
+	err = s.StoreLabels([]Label{bug, ui})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	web := Project{ID: "web", Name: "Web", Color: "#4ea7fc", Teams: []Team{team}}
+	err = s.StoreProjects([]Project{web})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().UTC()
+	daysAgo := func(n int) time.Time {
+		return now.Add(-time.Duration(n) * 24 * time.Hour)
+	}
+
+	err = s.StoreIssues([]Issue{
+		{
+			ID: "1", Identifier: "ENG-1", Title: "fix: login crash", Priority: 1,
+			Team: team, State: started, Assignee: me, Project: web, Labels: []Label{bug},
+			CreatedAt: daysAgo(2), UpdatedAt: now,
+		},
+		{
+			ID: "2", Identifier: "ENG-2", Title: "feat: export", Priority: 3,
+			Team: team, State: todo, Assignee: ana,
+			CreatedAt: daysAgo(60), UpdatedAt: daysAgo(40),
+		},
+		{
+			ID: "3", Identifier: "ENG-3", Title: "Crash on save", Priority: 0,
+			Team: team, State: done, Labels: []Label{bug, ui},
+			CreatedAt: daysAgo(400), UpdatedAt: daysAgo(300),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return s
+}
+
+func identifiers(t *testing.T, s *Store) []string {
+	t.Helper()
+
+	issues, err := s.Issues()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, issue := range issues {
+		ids = append(ids, issue.Identifier)
+	}
+	slices.Sort(ids)
+
+	return ids
+}
+
+func TestParseQuery(t *testing.T) {
+	tests := []struct {
+		query   string
+		text    string
+		filters int
+	}{
+		{query: "crash", text: "crash"},
+		{query: "fix: login crash", text: "fix: login crash"},
+		{query: "feat:export", text: "feat:export"},
+		{query: "assignee:me crash", text: "crash", filters: 1},
+		{query: `state:"In Progress",Todo -label:bug "login page"`, text: "login page", filters: 2},
+		{query: `label:"a,b",c`, filters: 1},
+		{query: "prio:<=2 age:>30d updated:<1w", filters: 3},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if query.Text != test.text {
+				t.Errorf("expected text %q, got %q", test.text, query.Text)
+			}
+			if len(query.where) != test.filters {
+				t.Errorf("expected %d filters, got %d: %v", test.filters, len(query.where), query.where)
+			}
+		})
+	}
+}
+
+func TestParseQueryError(t *testing.T) {
+	tests := []struct {
+		query string
+		pos   int
+	}{
+		{query: `"login page`, pos: 0},
+		{query: `crash state:"In Progress`, pos: 12},
+		{query: "assignee:", pos: 9},
+		{query: "crash prio:soon", pos: 11},
+		{query: "prio:<1,2", pos: 5},
+		{query: "age:30d", pos: 4},
+		{query: "updated:>3q", pos: 8},
+		{query: "has:estimate", pos: 4},
+		{query: "is:done", pos: 3},
+		{query: "label:bug,", pos: 6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			_, err := ParseQuery(test.query)
+
+			queryErr, ok := err.(*QueryError)
+			if !ok {
+				t.Fatalf("expected a query error, got %v", err)
+			}
+			if queryErr.Pos != test.pos {
+				t.Errorf("expected the error at %d, got %d: %s", test.pos, queryErr.Pos, queryErr.Msg)
+			}
+		})
+	}
+}
+
+func TestQueryIssues(t *testing.T) {
+	s := newTestStore(t)
+
+	tests := []struct {
+		query  string
+		issues []string
+	}{
+		{query: "", issues: []string{"ENG-1", "ENG-2", "ENG-3"}},
+		{query: "assignee:me", issues: []string{"ENG-1"}},
+		{query: "assignee:ana,none", issues: []string{"ENG-2", "ENG-3"}},
+		{query: "assignee:ana@example.com", issues: []string{"ENG-2"}},
+		// the unassigned issue has no user to compare, it still isn't mine
+		{query: "-assignee:me", issues: []string{"ENG-2", "ENG-3"}},
+		{query: "-project:web", issues: []string{"ENG-2", "ENG-3"}},
+		{query: `state:"In Progress",todo`, issues: []string{"ENG-1", "ENG-2"}},
+		{query: "state:completed", issues: []string{"ENG-3"}},
+		{query: "label:bug", issues: []string{"ENG-1", "ENG-3"}},
+		{query: "label:ui,bug", issues: []string{"ENG-1", "ENG-3"}},
+		{query: "-label:bug", issues: []string{"ENG-2"}},
+		{query: "team:engineering", issues: []string{"ENG-1", "ENG-2", "ENG-3"}},
+		{query: "team:eng", issues: []string{"ENG-1", "ENG-2", "ENG-3"}},
+		{query: "prio:urgent", issues: []string{"ENG-1"}},
+		{query: "priority:1,medium", issues: []string{"ENG-1", "ENG-2"}},
+		{query: "prio:none", issues: []string{"ENG-3"}},
+		// no priority ranks below low
+		{query: "prio:<=3", issues: []string{"ENG-1", "ENG-2"}},
+		{query: "prio:>=2", issues: []string{"ENG-2"}},
+		{query: "prio:>high", issues: []string{"ENG-2"}},
+		{query: "age:>30d", issues: []string{"ENG-2", "ENG-3"}},
+		{query: "age:<1w", issues: []string{"ENG-1"}},
+		{query: "age:>=1y", issues: []string{"ENG-3"}},
+		{query: "updated:>1mo", issues: []string{"ENG-2", "ENG-3"}},
+		{query: "updated:<48h", issues: []string{"ENG-1"}},
+		{query: "has:project", issues: []string{"ENG-1"}},
+		{query: "-has:assignee", issues: []string{"ENG-3"}},
+		{query: "has:labels", issues: []string{"ENG-1", "ENG-3"}},
+		{query: "assignee:me,ana -label:ui prio:<=3", issues: []string{"ENG-1", "ENG-2"}},
+		{query: "fix: crash", issues: []string{"ENG-1", "ENG-2", "ENG-3"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.query, func(t *testing.T) {
+			query, err := ParseQuery(test.query)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s.SetQuery(query)
+			defer s.SetQuery(nil)
+
+			got := identifiers(t, s)
+			if !slices.Equal(got, test.issues) {
+				t.Errorf("expected %v, got %v", test.issues, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package store
+
+import (
+	"fmt"
+	"slices"
+)
+
+var sortModeNames = []string{"smart", "project", "title", "assignee", "state", "prio", "age", "team", "milestone"}
+
+func (m SortMode) String() string {
+	if int(m) < 0 || int(m) >= len(sortModeNames) {
+		return sortModeNames[SortModeSmart]
+	}
+	return sortModeNames[m]
+}
+
+func ParseSortMode(s string) (SortMode, error) {
+	i := slices.Index(sortModeNames, s)
+	if i == -1 {
+		return SortModeSmart, fmt.Errorf("unknown sort mode %s", s)
+	}
+	return SortMode(i), nil
+}
+
+// SavedFilter is a filter query saved under a name together with the sort
+// and project it was used with.
+type SavedFilter struct {
+	Name        string
+	Query       string
+	SortMode    SortMode
+	Descending  bool
+	ProjectID   string
+	ProjectName string
+}
+
+// SavedFilters returns the saved filters of the active org in the order they
+// were first saved, which is the order of their number shortcuts.
+func (s *Store) SavedFilters() ([]SavedFilter, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var filters []SavedFilter
+	err := s.db.Select(&filters, fmt.Sprintf(`
+		SELECT saved_filters.name, saved_filters.query, saved_filters.sort_mode,
+			saved_filters.descending,
+			COALESCE(projects.id, '') AS project_id,
+			COALESCE(projects.name, '') AS project_name
+		FROM saved_filters
+		LEFT JOIN projects ON projects.id = saved_filters.project_id
+		WHERE saved_filters.org_id = %s
+		ORDER BY saved_filters.rowid`, currentOrg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select saved filters: %w", err)
+	}
+
+	return filters, nil
+}
+
+// SaveFilter saves the query under name with the current sort and project,
+// replacing the filter saved under that name before.
+func (s *Store) SaveFilter(name, query string) error {
+	filter := SavedFilter{
+		Name:       name,
+		Query:      query,
+		SortMode:   s.current.Org.SortMode,
+		Descending: s.current.Org.SortOrder == sortOrderDesc,
+	}
+
+	if s.current.Project != nil {
+		filter.ProjectID = s.current.Project.ID
+	}
+
+	return s.StoreSavedFilters([]SavedFilter{filter})
+}
+
+func (s *Store) StoreSavedFilters(filters []SavedFilter) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	if len(filters) == 0 {
+		return nil
+	}
+
+	// NOTE: on conflict the row is updated in place, keeping its shortcut
+	_, err := s.db.NamedExec(fmt.Sprintf(`
+		INSERT INTO saved_filters (name, query, sort_mode, descending, project_id, org_id)
+		VALUES (:name, :query, :sort_mode, :descending, NULLIF(:project_id, ''), %s)
+		ON CONFLICT (org_id, name) DO UPDATE
+		SET query = EXCLUDED.query,
+			sort_mode = EXCLUDED.sort_mode,
+			descending = EXCLUDED.descending,
+			project_id = EXCLUDED.project_id`, currentOrg),
+		filters,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't store saved filters: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) DeleteSavedFilter(name string) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	_, err := s.db.Exec(fmt.Sprintf(`
+		DELETE FROM saved_filters
+		WHERE name = ? AND org_id = %s`, currentOrg),
+		name,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't delete saved filter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// searchRank weighs the search columns in the order they're declared, id
+// first, so titles count most, then labels and descriptions least.
+const searchRank = "bm25(search, 0.0, 10.0, 1.0, 2.0, 2.0, 2.0, 2.0, 5.0)"
+
+// marks matches in snippets, stripped before they're returned
+const (
+	snippetStart = "\x01"
+	snippetEnd   = "\x02"
+)
+
+const searchSnippet = `snippet(search, 2, char(1), char(2), '…', 12)`
+
+// max number of typo corrections a single word matches
+const maxTypos = 8
+
+// searchWords splits search the way the index tokenizes.
+func searchWords(search string) []string {
+	return strings.FieldsFunc(strings.ToLower(search), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// typoDistance is how many edits a word may be off by and still match, short
+// words would match all kinds of other words.
+func typoDistance(word string) int {
+	switch n := utf8.RuneCountInString(word); {
+	case n >= 8:
+		return 2
+	case n >= 4:
+		return 1
+	}
+	return 0
+}
+
+// searchExpr builds the MATCH expression of search, every word matches the
+// beginning of an indexed word or an indexed word a typo away. Terms are
+// the words with their typo corrections, for highlighting.
+func (s *Store) searchExpr(search string) (expr string, terms []string, err error) {
+	var groups []string
+
+	for _, word := range searchWords(search) {
+		alternatives := []string{fmt.Sprintf(`"%s"*`, word)}
+		terms = append(terms, word)
+
+		typos, err := s.typosOf(word)
+		if err != nil {
+			return "", nil, err
+		}
+
+		for _, typo := range typos {
+			alternatives = append(alternatives, fmt.Sprintf(`"%s"`, typo))
+			terms = append(terms, typo)
+		}
+
+		groups = append(groups, "("+strings.Join(alternatives, " OR ")+")")
+	}
+
+	return strings.Join(groups, " AND "), terms, nil
+}
+
+func (s *Store) typosOf(word string) ([]string, error) {
+	dist := typoDistance(word)
+	if dist == 0 {
+		return nil, nil
+	}
+
+	n := utf8.RuneCountInString(word)
+
+	// NOTE: the first letter is rarely mistyped, it narrows the vocabulary down a lot
+	first, _ := utf8.DecodeRuneInString(word)
+
+	var candidates []string
+	err := s.db.Select(&candidates, `
+		SELECT term FROM search_terms
+		WHERE term >= ? AND term < ? AND LENGTH(term) BETWEEN ? AND ?`,
+		string(first),
+		string(first+1),
+		n-dist,
+		n+dist,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select search terms: %w", err)
+	}
+
+	type typo struct {
+		term string
+		dist int
+	}
+
+	var typos []typo
+	for _, candidate := range candidates {
+		// already matched as a prefix
+		if strings.HasPrefix(candidate, word) {
+			continue
+		}
+		if d := editDistance(word, candidate); d <= dist {
+			typos = append(typos, typo{term: candidate, dist: d})
+		}
+	}
+
+	slices.SortStableFunc(typos, func(a, b typo) int {
+		return a.dist - b.dist
+	})
+
+	var terms []string
+	for _, t := range typos[:min(len(typos), maxTypos)] {
+		terms = append(terms, t.term)
+	}
+
+	return terms, nil
+}
+
+// StoreRemoteIssues caches issues found by searching linear, they show up
+// in searches until a sync touches them.
+func (s *Store) StoreRemoteIssues(issues []Issue) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	var issueIDs []string
+	for i := range issues {
+		issues[i].Remote = true
+		issueIDs = append(issueIDs, issues[i].ID)
+	}
+
+	err := s.StoreIssues(issues)
+	if err != nil {
+		return fmt.Errorf("couldn't store remote issues: %w", err)
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start index remote issues tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	query, args, err := sqlx.In(`DELETE FROM search WHERE id IN (?)`, issueIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate unindex issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't unindex remote issues: %w", err)
+	}
+
+	query, args, err = sqlx.In(fmt.Sprintf(searchIndexInsert, `issues.id IN (?) AND issues.archived_at IS NULL`), issueIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate index issues query: %w", err)
+	}
+
+	_, err = tx.Exec(query, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't index remote issues: %w", err)
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit index remote issues tx: %w", err)
+	}
+
+	return nil
+}
+
+// editDistance counts the insertions, deletions, substitutions and swaps of
+// adjacent letters between a and b.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	d := make([][]int, len(ra)+1)
+	for i := range d {
+		d[i] = make([]int, len(rb)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+
+	return d[len(ra)][len(rb)]
+}
+
+// cleanSnippet turns a description snippet into a single line, it's empty
+// when the description didn't match.
+func cleanSnippet(snippet string) string {
+	if !strings.Contains(snippet, snippetStart) {
+		return ""
+	}
+
+	snippet = strings.NewReplacer(snippetStart, "", snippetEnd, "").Replace(snippet)
+
+	return strings.Join(strings.Fields(snippet), " ")
+}
@@ -15,6 +15,7 @@ import (
 
 type sortOrder int
 type SortMode int
+type GroupMode int
 
 const (
 	sortOrderAsc sortOrder = iota
@@ -30,10 +31,34 @@ const (
 	SortModePrio
 	SortModeAge
 	SortModeTeam
+	SortModeMilestone
+)
+
+const (
+	GroupModeNone GroupMode = iota
+	GroupModeState
+	GroupModeAssignee
+	GroupModeProject
+	GroupModePrio
+	GroupModeTeam
+	GroupModeLabel
 )
 
 const currentOrg = "(SELECT id FROM orgs WHERE active = TRUE)"
 
+const projectColumns = `
+	projects.id, projects.name, projects.color, projects.description,
+	projects.state, projects.lead_name, projects.health, projects.progress,
+	projects.start_date, projects.target_date,
+	(
+		SELECT COUNT(*) FROM issues
+		JOIN states ON states.id = issues.state_id
+		WHERE issues.project_id = projects.id AND
+			issues.archived_at IS NULL AND
+			states.type NOT IN ('completed', 'canceled') AND
+			states.name NOT IN ('Done', 'Canceled')
+	) AS open_issues`
+
 var matchFirstCap = regexp.MustCompile("(.)([A-Z][a-z]+)")
 var matchAllCap = regexp.MustCompile("([a-z0-9])([A-Z])")
 
@@ -83,11 +108,15 @@ func getEmptyProjectID(orgID string) string {
 }
 
 type StoreState struct {
-	Search    string
-	Project   *Project
-	Org       Org
-	Me        User
-	FirstTime bool
+	Search     string
+	Project    *Project
+	Milestone  *ProjectMilestone
+	CustomView *CustomView
+	Archive    bool
+	Query      *Query
+	Org        Org
+	Me         User
+	FirstTime  bool
 }
 
 type Store struct {
@@ -158,11 +187,11 @@ func (s *Store) StoreOrg(org Org) (bool, error) {
 		_, err := s.db.Exec(`
 			UPDATE orgs SET active = FALSE WHERE active = TRUE;
 
-			INSERT INTO orgs (id, name, url_key, active) 
-			VALUES (?, ?, ?, TRUE)
+			INSERT INTO orgs (id, name, url_key, workspace, active) 
+			VALUES (?, ?, ?, ?, TRUE)
 			ON CONFLICT (id) DO UPDATE
-			SET name = EXCLUDED.name, url_key = EXCLUDED.url_key, active = TRUE;
-		`, org.ID, org.Name, org.URLKey)
+			SET name = EXCLUDED.name, url_key = EXCLUDED.url_key, workspace = EXCLUDED.workspace, active = TRUE;
+		`, org.ID, org.Name, org.URLKey, org.Workspace)
 		if err != nil {
 			return false, fmt.Errorf("couldn't update org: %w", err)
 		}
@@ -180,10 +209,86 @@ func (s *Store) StoreOrg(org Org) (bool, error) {
 		if err != nil {
 			return false, fmt.Errorf("couldn't insert empty project for org: %w", err)
 		}
+	} else if s.current.Org.Workspace != org.Workspace {
+		_, err := s.db.Exec("UPDATE orgs SET workspace = ? WHERE id = ?", org.Workspace, org.ID)
+		if err != nil {
+			return false, fmt.Errorf("couldn't update org workspace: %w", err)
+		}
+		s.current.Org.Workspace = org.Workspace
 	}
 	return changed, nil
 }
 
+// SetWorkingOn marks the issue being worked on in the active org, an empty
+// issueID clears the mark.
+func (s *Store) SetWorkingOn(issueID string) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	_, err := s.db.Exec("UPDATE orgs SET working_on_issue_id = ? WHERE active = TRUE", issueID)
+	if err != nil {
+		return fmt.Errorf("couldn't set working on issue: %w", err)
+	}
+
+	s.current.Org.WorkingOnIssueID = issueID
+
+	return nil
+}
+
+func (s *Store) WorkingOn() (*Issue, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	if s.current.Org.WorkingOnIssueID == "" {
+		return nil, nil
+	}
+
+	return s.Issue(s.current.Org.WorkingOnIssueID)
+}
+
+// SetActiveOrg switches the cached data every query works on to another org,
+// an empty orgID leaves no org active until the next StoreOrg.
+func (s *Store) SetActiveOrg(orgID string) error {
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't start set active org tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec("UPDATE orgs SET active = FALSE WHERE active = TRUE")
+	if err != nil {
+		return fmt.Errorf("couldn't deactivate orgs: %w", err)
+	}
+
+	if orgID != "" {
+		res, err := tx.Exec("UPDATE orgs SET active = TRUE WHERE id = ?", orgID)
+		if err != nil {
+			return fmt.Errorf("couldn't activate org: %w", err)
+		}
+		if n, _ := res.RowsAffected(); n == 0 {
+			return fmt.Errorf("couldn't find org %s", orgID)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit set active org tx: %w", err)
+	}
+
+	s.current = StoreState{}
+
+	err = s.loadCurrentState()
+	if err != nil {
+		return fmt.Errorf("couldn't load state of org: %w", err)
+	}
+
+	s.current.FirstTime = s.current.Org.ID == "" || s.current.Me.ID == ""
+
+	return nil
+}
+
 func (s *Store) Users() ([]User, error) {
 	if s.current.Org.ID == "" {
 		return nil, ErrNoOrgSelected
@@ -219,7 +324,7 @@ func (s *Store) StoreUsers(users []User) error {
 		ON CONFLICT (id) DO UPDATE 
 		SET name = EXCLUDED.name,
 			display_name = EXCLUDED.display_name,
-			email = EXCLUDED.display_name,
+			email = EXCLUDED.email,
 			is_me = EXCLUDED.is_me
 		`, currentOrg),
 		users,
@@ -248,9 +353,10 @@ func (s *Store) States(teamID string) ([]State, error) {
 
 	var states []State
 	err := s.db.Select(&states, fmt.Sprintf(`
-		SELECT id, name, color, team_id 
+		SELECT id, name, color, type, position, team_id 
 		FROM states 
-		WHERE team_id = ? AND org_id = %s`, currentOrg),
+		WHERE team_id = ? AND org_id = %s
+		ORDER BY position`, currentOrg),
 		teamID,
 	)
 	if err != nil {
@@ -271,11 +377,13 @@ func (s *Store) StoreStates(states []State) error {
 	}
 
 	_, err := s.db.NamedExec(fmt.Sprintf(`
-		INSERT INTO states (id, name, color, team_id, org_id)
-		VALUES (:id, :name, :color, :team_id, %s)
+		INSERT INTO states (id, name, color, type, position, team_id, org_id)
+		VALUES (:id, :name, :color, :type, :position, :team_id, %s)
 		ON CONFLICT (id) DO UPDATE 
 		SET name = EXCLUDED.name, 
 			color = EXCLUDED.color,
+			type = COALESCE(NULLIF(EXCLUDED.type, ''), states.type),
+			position = EXCLUDED.position,
 			team_id = EXCLUDED.team_id
 		`, currentOrg),
 		states,
@@ -418,10 +526,10 @@ func (s *Store) Projects() ([]Project, error) {
 
 	var projects []Project
 	err := s.db.Select(&projects, fmt.Sprintf(`
-		SELECT id, name, color 
+		SELECT %s
 		FROM projects
 		WHERE org_id = %s
-		ORDER BY name`, currentOrg),
+		ORDER BY name`, projectColumns, currentOrg),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't select projects: %w", err)
@@ -430,6 +538,58 @@ func (s *Store) Projects() ([]Project, error) {
 	return projects, nil
 }
 
+func (s *Store) Project(projectID string) (*Project, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var project Project
+	err := s.db.Get(&project, fmt.Sprintf(`
+		SELECT %s
+		FROM projects
+		WHERE id = ? AND org_id = %s`, projectColumns, currentOrg),
+		projectID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select project: %w", err)
+	}
+
+	err = s.db.Select(&project.Milestones, `
+		SELECT id, name, target_date, sort_order, project_id
+		FROM project_milestones
+		WHERE project_id = ?
+		ORDER BY sort_order`,
+		projectID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select project milestones: %w", err)
+	}
+
+	return &project, nil
+}
+
+func (s *Store) Milestones() ([]ProjectMilestone, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var milestones []ProjectMilestone
+	err := s.db.Select(&milestones, fmt.Sprintf(`
+		SELECT project_milestones.id, project_milestones.name,
+			project_milestones.target_date, project_milestones.sort_order,
+			project_milestones.project_id
+		FROM project_milestones
+		JOIN projects ON projects.id = project_milestones.project_id
+		WHERE projects.org_id = %s
+		ORDER BY project_milestones.sort_order`, currentOrg),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select milestones: %w", err)
+	}
+
+	return milestones, nil
+}
+
 func (s *Store) StoreProjects(projects []Project) error {
 	if s.current.Org.ID == "" {
 		return nil
@@ -447,11 +607,24 @@ func (s *Store) StoreProjects(projects []Project) error {
 	defer tx.Rollback()
 
 	_, err = tx.NamedExec(fmt.Sprintf(`
-		INSERT INTO projects (id, name, color, org_id)
-		VALUES (:id, :name, :color, %s)
+		INSERT INTO projects (
+			id, name, color, description, state, lead_name,
+			health, progress, start_date, target_date, org_id
+		)
+		VALUES (
+			:id, :name, :color, :description, :state, :lead_name,
+			:health, :progress, :start_date, :target_date, %s
+		)
 		ON CONFLICT (id) DO UPDATE 
 		SET name = EXCLUDED.name, 
-			color = EXCLUDED.color
+			color = EXCLUDED.color,
+			description = EXCLUDED.description,
+			state = EXCLUDED.state,
+			lead_name = EXCLUDED.lead_name,
+			health = EXCLUDED.health,
+			progress = EXCLUDED.progress,
+			start_date = EXCLUDED.start_date,
+			target_date = EXCLUDED.target_date
 		`, currentOrg), projects,
 	)
 	if err != nil {
@@ -463,19 +636,65 @@ func (s *Store) StoreProjects(projects []Project) error {
 		ProjectID string
 	}
 
+	// projects can be shared with teams we aren't a member of
+	var teamIDs []string
+	err = tx.Select(&teamIDs, fmt.Sprintf("SELECT id FROM teams WHERE org_id = %s", currentOrg))
+	if err != nil {
+		return fmt.Errorf("couldn't select teams: %w", err)
+	}
+
+	knownTeams := make(map[string]struct{})
+	for _, teamID := range teamIDs {
+		knownTeams[teamID] = struct{}{}
+	}
+
 	var projectIDs []string
 	var teamProjects []teamProject
+	var milestones []ProjectMilestone
 	for _, project := range projects {
 		projectIDs = append(projectIDs, project.ID)
 		for _, team := range project.Teams {
+			if _, ok := knownTeams[team.ID]; !ok {
+				continue
+			}
 			teamProjects = append(teamProjects, teamProject{
 				TeamID:    team.ID,
 				ProjectID: project.ID,
 			})
 		}
+		for _, milestone := range project.Milestones {
+			milestone.ProjectID = project.ID
+			milestones = append(milestones, milestone)
+		}
 	}
 
-	q, args, err := sqlx.In("DELETE FROM team_project WHERE id IN (?)", projectIDs)
+	q, args, err := sqlx.In("DELETE FROM project_milestones WHERE project_id IN (?)", projectIDs)
+	if err != nil {
+		return fmt.Errorf("couldn't generate delete project milestones query: %w", err)
+	}
+
+	_, err = tx.Exec(q, args...)
+	if err != nil {
+		return fmt.Errorf("couldn't delete project milestones: %w", err)
+	}
+
+	if len(milestones) > 0 {
+		_, err = tx.NamedExec(`
+			INSERT INTO project_milestones (id, name, target_date, sort_order, project_id)
+			VALUES (:id, :name, :target_date, :sort_order, :project_id)
+			ON CONFLICT (id) DO UPDATE
+			SET name = EXCLUDED.name,
+				target_date = EXCLUDED.target_date,
+				sort_order = EXCLUDED.sort_order,
+				project_id = EXCLUDED.project_id`,
+			milestones,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store project milestones: %w", err)
+		}
+	}
+
+	q, args, err = sqlx.In("DELETE FROM team_project WHERE project_id IN (?)", projectIDs)
 	if err != nil {
 		return fmt.Errorf("couldn't generate delete team project relations query: %w", err)
 	}
@@ -485,14 +704,65 @@ func (s *Store) StoreProjects(projects []Project) error {
 		return fmt.Errorf("couldn't delete team relations: %w", err)
 	}
 
-	_, err = tx.NamedExec(`
-		INSERT INTO team_project (team_id, project_id)
-		VALUES (:team_id, :project_id)
-		ON CONFLICT (id) DO NOTHING`,
-		teamProjects,
+	if len(teamProjects) > 0 {
+		_, err = tx.NamedExec(`
+			INSERT INTO team_project (team_id, project_id)
+			VALUES (:team_id, :project_id)
+			ON CONFLICT (team_id, project_id) DO NOTHING`,
+			teamProjects,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store project team relations: %w", err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit store projects tx: %w", err)
+	}
+
+	return nil
+}
+
+// storeIssueProjects only knows what issues carry about their project,
+// so it must not touch the metadata synced by StoreProjects.
+func (s *Store) storeIssueProjects(projects []Project) error {
+	projects = removeDuplicatesAndEmpties(projects)
+	if len(projects) == 0 {
+		return nil
+	}
+
+	_, err := s.db.NamedExec(fmt.Sprintf(`
+		INSERT INTO projects (id, name, color, org_id)
+		VALUES (:id, :name, :color, %s)
+		ON CONFLICT (id) DO UPDATE 
+		SET name = EXCLUDED.name, 
+			color = EXCLUDED.color
+		`, currentOrg), projects,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't store issue projects: %w", err)
+	}
+
+	return nil
+}
+
+func (s *Store) storeIssueMilestones(milestones []ProjectMilestone) error {
+	milestones = removeDuplicatesAndEmpties(milestones)
+	if len(milestones) == 0 {
+		return nil
+	}
+
+	_, err := s.db.NamedExec(`
+		INSERT INTO project_milestones (id, name, sort_order, project_id)
+		VALUES (:id, :name, :sort_order, :project_id)
+		ON CONFLICT (id) DO UPDATE 
+		SET name = EXCLUDED.name,
+			project_id = EXCLUDED.project_id`,
+		milestones,
 	)
 	if err != nil {
-		return fmt.Errorf("couldn't store project team relations: %w", err)
+		return fmt.Errorf("couldn't store issue milestones: %w", err)
 	}
 
 	return nil
@@ -524,27 +794,42 @@ func (s *Store) Issue(issueID string) (*Issue, error) {
 				GROUP BY issue_id
 			)
 			SELECT 
-				issues.id, identifier, title,
-				priority, description,
+				issues.id, identifier, title, branch_name,
+				priority, issues.description,
 				pinned, created_at, updated_at, canceled_at,
+			archived_at, trashed, remote,
 				states.id AS "state.id",
 				states.name AS "state.name",
 				states.color AS "state.color",
+				states.type AS "state.type",
 				teams.id AS "team.id",
 				teams.name AS "team.name",
 				teams.color AS "team.color",
 				COALESCE(projects.id, '') AS "project.id",
 				COALESCE(projects.name, '') AS "project.name",
 				COALESCE(projects.color, '') AS "project.color",
+				COALESCE(project_milestones.id, '') AS "milestone.id",
+				COALESCE(project_milestones.name, '') AS "milestone.name",
+				COALESCE(project_milestones.sort_order, 0) AS "milestone.sort_order",
 				COALESCE(users.id, '') AS "assignee.id",
 				COALESCE(users.name, '') AS "assignee.name",
 				COALESCE(users.display_name, '') AS "assignee.display_name",
 				COALESCE(users.email, '') AS "assignee.email",
 				COALESCE(users.is_me, FALSE) AS "assignee.is_me",
+				issues.estimate, issues.due_date,
+				issues.cycle_number AS "cycle.number",
+				issues.cycle_name AS "cycle.name",
+				COALESCE(creators.id, '') AS "creator.id",
+				COALESCE(creators.name, '') AS "creator.name",
+				COALESCE(creators.display_name, '') AS "creator.display_name",
+				COALESCE(creators.email, '') AS "creator.email",
+				COALESCE(creators.is_me, FALSE) AS "creator.is_me",
 				COALESCE(json_labels.labels, '') AS issue_labels
 			FROM issues
 			LEFT JOIN users ON issues.assignee_id = users.id
+			LEFT JOIN users AS creators ON issues.creator_id = creators.id
 			LEFT JOIN projects ON issues.project_id = projects.id
+			LEFT JOIN project_milestones ON issues.project_milestone_id = project_milestones.id
 			LEFT JOIN teams ON issues.team_id = teams.id
 			LEFT JOIN states ON issues.state_id = states.id
 			LEFT JOIN json_labels ON json_labels.issue_id = issues.id
@@ -562,9 +847,110 @@ func (s *Store) Issue(issueID string) (*Issue, error) {
 		}
 	}
 
+	res.Commits, err = s.Commits(issueID)
+	if err != nil {
+		return nil, err
+	}
+
 	return &res.Issue, nil
 }
 
+// IssueIDByBranch finds the issue a git branch was made for, either by the
+// branch name linear suggested or an identifier somewhere in the name.
+func (s *Store) IssueIDByBranch(branch string) (string, error) {
+	if s.current.Org.ID == "" {
+		return "", ErrNoOrgSelected
+	}
+
+	var issueID string
+	err := s.db.Get(&issueID, fmt.Sprintf(`
+		SELECT id FROM issues
+		WHERE org_id = %s AND (
+			branch_name = ? OR
+			? LIKE '%%' || identifier || '%%'
+		)
+		ORDER BY branch_name = ? DESC, LENGTH(identifier) DESC
+		LIMIT 1`, currentOrg),
+		branch, branch, branch,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("couldn't select issue of branch: %w", err)
+	}
+
+	return issueID, nil
+}
+
+// HasIdentifierPrefix tells if any issue is numbered under the team key prefix.
+func (s *Store) HasIdentifierPrefix(prefix string) (bool, error) {
+	if s.current.Org.ID == "" {
+		return false, ErrNoOrgSelected
+	}
+
+	var exists bool
+	err := s.db.Get(&exists, fmt.Sprintf(`
+		SELECT EXISTS (
+			SELECT 1 FROM issues
+			WHERE identifier LIKE ? || '-%%' AND org_id = %s
+		)`, currentOrg),
+		prefix,
+	)
+	if err != nil {
+		return false, fmt.Errorf("couldn't check identifier prefix: %w", err)
+	}
+
+	return exists, nil
+}
+
+// TeamIDByKey finds a team by the key its issues are numbered with, team keys
+// themselves aren't synced.
+func (s *Store) TeamIDByKey(key string) (string, error) {
+	if s.current.Org.ID == "" {
+		return "", ErrNoOrgSelected
+	}
+
+	var teamID string
+	err := s.db.Get(&teamID, fmt.Sprintf(`
+		SELECT team_id FROM issues
+		WHERE identifier LIKE ? || '-%%' AND org_id = %s
+		LIMIT 1`, currentOrg),
+		key,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("couldn't select team of key: %w", err)
+	}
+
+	return teamID, nil
+}
+
+// IssueIDByIdentifier finds an issue by its identifier like ENG-123, it's
+// empty when the issue isn't stored.
+func (s *Store) IssueIDByIdentifier(identifier string) (string, error) {
+	if s.current.Org.ID == "" {
+		return "", ErrNoOrgSelected
+	}
+
+	var issueID string
+	err := s.db.Get(&issueID, fmt.Sprintf(`
+		SELECT id FROM issues
+		WHERE identifier = ? AND org_id = %s`, currentOrg),
+		strings.ToUpper(identifier),
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("couldn't select issue by identifier: %w", err)
+	}
+
+	return issueID, nil
+}
+
 func (s *Store) Issues(issueIDs ...string) ([]Issue, error) {
 	if s.current.Org.ID == "" {
 		return nil, ErrNoOrgSelected
@@ -575,6 +961,14 @@ func (s *Store) Issues(issueIDs ...string) ([]Issue, error) {
 		return nil, fmt.Errorf("failed to generate issue filter query: %w", err)
 	}
 
+	customViewFilterQuery, customViewArgs := s.getCustomViewFilter()
+	args = append(args, customViewArgs...)
+
+	queryFilter, queryArgs := s.getQueryFilter()
+	args = append(args, queryArgs...)
+
+	groupColumns, groupJoin, groupOrder := s.getGrouper()
+
 	query := fmt.Sprintf(`
 		WITH json_labels AS (
 			SELECT issue_id, 
@@ -591,12 +985,14 @@ func (s *Store) Issues(issueIDs ...string) ([]Issue, error) {
 			GROUP BY issue_id
 		)
 		SELECT 
-			issues.id, identifier, title,
-			priority, description,
+			issues.id, identifier, title, branch_name,
+			priority, issues.description,
 			pinned, created_at, updated_at, canceled_at,
+			archived_at, trashed, remote,
 			states.id AS "state.id",
 			states.name AS "state.name",
 			states.color AS "state.color",
+			states.type AS "state.type",
 			states.team_id AS "state.team_id",
 			teams.id AS "team.id",
 			teams.name AS "team.name",
@@ -604,26 +1000,42 @@ func (s *Store) Issues(issueIDs ...string) ([]Issue, error) {
 			COALESCE(projects.id, '') AS "project.id",
 			COALESCE(projects.name, '') AS "project.name",
 			COALESCE(projects.color, '') AS "project.color",
+			COALESCE(project_milestones.id, '') AS "milestone.id",
+			COALESCE(project_milestones.name, '') AS "milestone.name",
+			COALESCE(project_milestones.sort_order, 0) AS "milestone.sort_order",
 			COALESCE(users.id, '') AS "assignee.id",
 			COALESCE(users.name, '') AS "assignee.name",
 			COALESCE(users.display_name, '') AS "assignee.display_name",
 			COALESCE(users.email, '') AS "assignee.email",
 			COALESCE(users.is_me, FALSE) AS "assignee.is_me",
-			COALESCE(json_labels.labels, '') AS issue_labels
+			issues.estimate, issues.due_date,
+			issues.cycle_number AS "cycle.number",
+			issues.cycle_name AS "cycle.name",
+			COALESCE(creators.id, '') AS "creator.id",
+			COALESCE(creators.name, '') AS "creator.name",
+			COALESCE(creators.display_name, '') AS "creator.display_name",
+			COALESCE(creators.email, '') AS "creator.email",
+			COALESCE(creators.is_me, FALSE) AS "creator.is_me",
+			COALESCE(json_labels.labels, '') AS issue_labels,
+			%s
 		FROM issues
 		INNER JOIN orgs ON issues.org_id = orgs.id
 		LEFT JOIN users ON issues.assignee_id = users.id
+		LEFT JOIN users AS creators ON issues.creator_id = creators.id
 		LEFT JOIN projects ON issues.project_id = projects.id
+		LEFT JOIN project_milestones ON issues.project_milestone_id = project_milestones.id
 		LEFT JOIN teams ON issues.team_id = teams.id
 		LEFT JOIN states ON issues.state_id = states.id
 		LEFT JOIN json_labels ON json_labels.issue_id = issues.id
-		WHERE %s %s orgs.active = TRUE AND (
+		%s
+		WHERE %s %s %s %s %s orgs.active = TRUE AND (
 			states.name NOT IN ('Done', 'Canceled') OR 
-			updated_at > DATETIME(CURRENT_TIMESTAMP, '-14 days')
+			updated_at > DATETIME(CURRENT_TIMESTAMP, '-14 days') OR
+			archived_at IS NOT NULL
 		)
-		ORDER BY pinned = TRUE DESC, 
+		ORDER BY %s pinned = TRUE DESC, 
 			%s
-	`, issueFilterQuery, s.getProjectFilter(), s.getSorter(false))
+	`, groupColumns, groupJoin, issueFilterQuery, s.getArchiveFilter(), s.getProjectFilter(), customViewFilterQuery, queryFilter, groupOrder, s.getSorter(""))
 
 	var issues []Issue
 	rows, err := s.db.Queryx(query, args...)
@@ -664,13 +1076,22 @@ func (s *Store) SearchIssues(search string) ([]Issue, error) {
 		return nil, ErrNoOrgSelected
 	}
 
-	var searchArg string
-	quoteRemoved := strings.ReplaceAll(search, "\"", " ")
-	keywordSplit := strings.Split(quoteRemoved, " ")
-	for _, keyword := range keywordSplit {
-		searchArg += fmt.Sprintf(`"%s" `, keyword)
+	searchExpr, terms, err := s.searchExpr(search)
+	if err != nil {
+		return nil, err
+	}
+	if searchExpr == "" {
+		return s.Issues()
 	}
 
+	customViewFilterQuery, args := s.getCustomViewFilter()
+
+	queryFilter, queryArgs := s.getQueryFilter()
+	args = append(args, queryArgs...)
+	args = append(args, searchExpr)
+
+	groupColumns, groupJoin, groupOrder := s.getGrouper()
+
 	query := fmt.Sprintf(`
 		WITH json_labels AS (
 			SELECT issue_id, 
@@ -690,43 +1111,64 @@ func (s *Store) SearchIssues(search string) ([]Issue, error) {
 			issues.id, 
 			issues.identifier, 
 			issues.title,
+			issues.branch_name,
 			priority, 
 			issues.description, 
 			pinned, created_at, 
 			updated_at, canceled_at,
+			archived_at, trashed, remote,
 			states.id AS "state.id",
 			states.name AS "state.name",
 			states.color AS "state.color",
+			states.type AS "state.type",
 			teams.id AS "team.id",
 			teams.name AS "team.name",
 			teams.color AS "team.color",
 			COALESCE(projects.id, '') AS "project.id",
 			COALESCE(projects.name, '') AS "project.name",
 			COALESCE(projects.color, '') AS "project.color",
+			COALESCE(project_milestones.id, '') AS "milestone.id",
+			COALESCE(project_milestones.name, '') AS "milestone.name",
+			COALESCE(project_milestones.sort_order, 0) AS "milestone.sort_order",
 			COALESCE(users.id, '') AS "assignee.id",
 			COALESCE(users.name, '') AS "assignee.name",
 			COALESCE(users.display_name, '') AS "assignee.display_name",
 			COALESCE(users.email, '') AS "assignee.email",
 			COALESCE(users.is_me, FALSE) AS "assignee.is_me",
-			COALESCE(json_labels.labels, '') AS issue_labels
+			issues.estimate, issues.due_date,
+			issues.cycle_number AS "cycle.number",
+			issues.cycle_name AS "cycle.name",
+			COALESCE(creators.id, '') AS "creator.id",
+			COALESCE(creators.name, '') AS "creator.name",
+			COALESCE(creators.display_name, '') AS "creator.display_name",
+			COALESCE(creators.email, '') AS "creator.email",
+			COALESCE(creators.is_me, FALSE) AS "creator.is_me",
+			COALESCE(json_labels.labels, '') AS issue_labels,
+			%s,
+			%s AS snippet
 		FROM issues
 		INNER JOIN orgs ON issues.org_id = orgs.id
 		INNER JOIN search ON issues.id = search.id
 		LEFT JOIN users ON issues.assignee_id = users.id
+		LEFT JOIN users AS creators ON issues.creator_id = creators.id
 		LEFT JOIN projects ON issues.project_id = projects.id
+		LEFT JOIN project_milestones ON issues.project_milestone_id = project_milestones.id
 		LEFT JOIN teams ON issues.team_id = teams.id
 		LEFT JOIN states ON issues.state_id = states.id
 		LEFT JOIN json_labels ON json_labels.issue_id = issues.id
-		WHERE %s orgs.active = TRUE AND search MATCH ? AND (
+		%s
+		WHERE %s %s %s %s orgs.active = TRUE AND search MATCH ? AND (
 			states.name NOT IN ('Done', 'Canceled') OR 
-			updated_at > DATETIME(CURRENT_TIMESTAMP, '-14 days')
+			updated_at > DATETIME(CURRENT_TIMESTAMP, '-14 days') OR
+			archived_at IS NOT NULL OR
+			remote = TRUE
 		)
-		ORDER BY pinned = TRUE DESC, 
+		ORDER BY %s pinned = TRUE DESC, 
 			%s
-	`, s.getProjectFilter(), s.getSorter(true))
+	`, groupColumns, searchSnippet, groupJoin, s.getArchiveFilter(), s.getProjectFilter(), customViewFilterQuery, queryFilter, groupOrder, s.getSorter(searchRank))
 
 	var issues []Issue
-	rows, err := s.db.Queryx(query, searchArg)
+	rows, err := s.db.Queryx(query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query searched issues: %w", err)
 	}
@@ -735,6 +1177,7 @@ func (s *Store) SearchIssues(search string) ([]Issue, error) {
 		var res struct {
 			Issue
 			IssueLabels string
+			Snippet     string
 		}
 
 		err := rows.StructScan(&res)
@@ -749,6 +1192,11 @@ func (s *Store) SearchIssues(search string) ([]Issue, error) {
 			}
 		}
 
+		res.Match = &SearchMatch{
+			Terms:   terms,
+			Snippet: cleanSnippet(res.Snippet),
+		}
+
 		issues = append(issues, res.Issue)
 	}
 
@@ -772,18 +1220,28 @@ func (s *Store) StoreIssues(issues []Issue) error {
 	var states []State
 	var labels []Label
 	var projects []Project
+	var milestones []ProjectMilestone
 
 	for _, issue := range issues {
 		states = append(states, issue.State)
 		projects = append(projects, issue.Project)
-		users = append(users, issue.Assignee)
+		if issue.Milestone.ID != "" {
+			milestone := issue.Milestone
+			milestone.ProjectID = issue.Project.ID
+			milestones = append(milestones, milestone)
+		}
+		users = append(users, issue.Assignee, issue.Creator)
 		labels = append(labels, issue.Labels...)
 	}
 
-	err := s.StoreProjects(projects)
+	err := s.storeIssueProjects(projects)
 	if err != nil {
 		return fmt.Errorf("failed to store projects: %w", err)
 	}
+	err = s.storeIssueMilestones(milestones)
+	if err != nil {
+		return fmt.Errorf("failed to store milestones: %w", err)
+	}
 	err = s.StoreStates(states)
 	if err != nil {
 		return fmt.Errorf("failed to store states: %w", err)
@@ -801,13 +1259,21 @@ func (s *Store) StoreIssues(issues []Issue) error {
 		ID          string
 		Identifier  string
 		Title       string
+		BranchName  string
 		Description string
 		Priority    Prio
 		TeamID      string
 		StateID     string
 		AssigneeID  sql.Null[string]
 		ProjectID   string
+		MilestoneID sql.Null[string]
+		CreatorID   sql.Null[string]
+		Estimate    *float64
+		DueDate     *time.Time
+		CycleNumber int
+		CycleName   string
 		Pinned      bool
+		Remote      bool
 		CreatedAt   time.Time
 		UpdatedAt   time.Time
 		CanceledAt  *time.Time
@@ -834,19 +1300,33 @@ func (s *Store) StoreIssues(issues []Issue) error {
 			ID:          issue.ID,
 			Identifier:  issue.Identifier,
 			Title:       issue.Title,
+			BranchName:  issue.BranchName,
 			Description: issue.Description,
 			Priority:    issue.Priority,
 			TeamID:      issue.Team.ID,
 			StateID:     issue.State.ID,
 			ProjectID:   projectID,
+			MilestoneID: sql.Null[string]{
+				Valid: issue.Milestone.ID != "",
+				V:     issue.Milestone.ID,
+			},
 			AssigneeID: sql.Null[string]{
 				Valid: issue.Assignee.ID != "",
 				V:     issue.Assignee.ID,
 			},
-			Pinned:     issue.Pinned,
-			CreatedAt:  issue.CreatedAt,
-			UpdatedAt:  issue.UpdatedAt,
-			CanceledAt: issue.CanceledAt,
+			CreatorID: sql.Null[string]{
+				Valid: issue.Creator.ID != "",
+				V:     issue.Creator.ID,
+			},
+			Estimate:    issue.Estimate,
+			DueDate:     issue.DueDate,
+			CycleNumber: issue.Cycle.Number,
+			CycleName:   issue.Cycle.Name,
+			Pinned:      issue.Pinned,
+			Remote:      issue.Remote,
+			CreatedAt:   issue.CreatedAt,
+			UpdatedAt:   issue.UpdatedAt,
+			CanceledAt:  issue.CanceledAt,
 		})
 
 		for _, label := range issue.Labels {
@@ -859,31 +1339,41 @@ func (s *Store) StoreIssues(issues []Issue) error {
 
 	_, err = s.db.NamedExec(fmt.Sprintf(`
 		INSERT INTO issues (
-			id, identifier, title, 
+			id, identifier, title, branch_name,
 			description, priority, 
 			team_id, state_id, assignee_id, 
-			project_id, pinned, created_at, 
-			updated_at, canceled_at, org_id
+			project_id, project_milestone_id, pinned, remote,
+			creator_id, estimate, due_date, cycle_number, cycle_name,
+			created_at, updated_at, canceled_at, org_id
 		)
 		VALUES (
-			:id, :identifier, :title, 
+			:id, :identifier, :title, :branch_name,
 			:description, :priority, 
 			:team_id, :state_id, :assignee_id, 
-			:project_id, :pinned, :created_at, 
-			:updated_at, :canceled_at, %s
+			:project_id, :milestone_id, :pinned, :remote,
+			:creator_id, :estimate, :due_date, :cycle_number, :cycle_name,
+			:created_at, :updated_at, :canceled_at, %s
 		)
 		ON CONFLICT (id) DO UPDATE
 		SET identifier = EXCLUDED.identifier,
 			title = EXCLUDED.title,
+			branch_name = EXCLUDED.branch_name,
 			priority = EXCLUDED.priority,
 			description = EXCLUDED.description,
 			state_id = EXCLUDED.state_id,
 			project_id = EXCLUDED.project_id,
+			project_milestone_id = EXCLUDED.project_milestone_id,
 			team_id = EXCLUDED.team_id,
 			assignee_id = EXCLUDED.assignee_id,
+			creator_id = EXCLUDED.creator_id,
+			estimate = EXCLUDED.estimate,
+			due_date = EXCLUDED.due_date,
+			cycle_number = EXCLUDED.cycle_number,
+			cycle_name = EXCLUDED.cycle_name,
 			created_at = EXCLUDED.created_at,
 			updated_at = EXCLUDED.updated_at,
-			canceled_at = EXCLUDED.canceled_at
+			canceled_at = EXCLUDED.canceled_at,
+			remote = EXCLUDED.remote
 		`, currentOrg),
 		issueModels,
 	)
@@ -946,12 +1436,13 @@ func (s *Store) SetBookmark(issueIDs ...string) error {
 type UpdateIssueField string
 
 const (
-	UpdateIssueFieldAssignee UpdateIssueField = "assignee_id"
-	UpdateIssueFieldPrio     UpdateIssueField = "priority"
-	UpdateIssueFieldProject  UpdateIssueField = "project_id"
-	UpdateIssueFieldTeam     UpdateIssueField = "team_id"
-	UpdateIssueFieldTitle    UpdateIssueField = "title"
-	UpdateIssueFieldState    UpdateIssueField = "state_id"
+	UpdateIssueFieldAssignee  UpdateIssueField = "assignee_id"
+	UpdateIssueFieldPrio      UpdateIssueField = "priority"
+	UpdateIssueFieldProject   UpdateIssueField = "project_id"
+	UpdateIssueFieldTeam      UpdateIssueField = "team_id"
+	UpdateIssueFieldTitle     UpdateIssueField = "title"
+	UpdateIssueFieldState     UpdateIssueField = "state_id"
+	UpdateIssueFieldMilestone UpdateIssueField = "project_milestone_id"
 )
 
 func (s *Store) UpdateIssues(field UpdateIssueField, value any, issueIDs ...string) error {
@@ -963,8 +1454,14 @@ func (s *Store) UpdateIssues(field UpdateIssueField, value any, issueIDs ...stri
 		return nil
 	}
 
+	// milestones belong to a project, so they can't survive a move
+	var resetMilestone string
+	if field == UpdateIssueFieldProject {
+		resetMilestone = "project_milestone_id = NULL,"
+	}
+
 	query, args, err := sqlx.In(
-		fmt.Sprintf(`UPDATE issues SET %s = ?, updated_at = ? WHERE id IN (?)`, field),
+		fmt.Sprintf(`UPDATE issues SET %s = ?, %s updated_at = ? WHERE id IN (?)`, field, resetMilestone),
 		value,
 		time.Now(),
 		issueIDs,
@@ -1057,6 +1554,22 @@ func (s *Store) SetSortMode(mode SortMode) error {
 		s.current.Org.SortMode = mode
 	}
 
+	return s.saveSort()
+}
+
+// SetSort sorts by mode in the given direction, unlike SetSortMode which
+// toggles the direction when mode is already in use.
+func (s *Store) SetSort(mode SortMode, descending bool) error {
+	s.current.Org.SortMode = mode
+	s.current.Org.SortOrder = sortOrderAsc
+	if descending {
+		s.current.Org.SortOrder = sortOrderDesc
+	}
+
+	return s.saveSort()
+}
+
+func (s *Store) saveSort() error {
 	_, err := s.db.Exec(`
 		UPDATE orgs 
 		SET sort_mode = ?, 
@@ -1073,6 +1586,27 @@ func (s *Store) SetSortMode(mode SortMode) error {
 
 func (s *Store) SetProject(project *Project) {
 	s.current.Project = project
+	s.current.Milestone = nil
+}
+
+func (s *Store) SetMilestone(milestone *ProjectMilestone) {
+	s.current.Milestone = milestone
+}
+
+// SetArchive switches issue queries between the issues in use and the ones
+// archived or deleted.
+func (s *Store) SetArchive(archive bool) {
+	s.current.Archive = archive
+}
+
+// SetQuery narrows issue queries down to the filters of a parsed query, its
+// text is left to SearchIssues.
+func (s *Store) SetQuery(query *Query) {
+	s.current.Query = query
+}
+
+func (s *Store) SetCustomView(view *CustomView) {
+	s.current.CustomView = view
 }
 
 func (s *Store) loadCurrentState() error {
@@ -1094,33 +1628,38 @@ func (s *Store) loadCurrentState() error {
 	return nil
 }
 
-func (s *Store) getSorter(includeRank bool) string {
+// getSorter orders by the sort mode, rank orders searched issues by
+// relevance first in the smart sort and last otherwise.
+func (s *Store) getSorter(rank string) string {
 	orderStr := "DESC"
 
 	if s.current.Org.SortOrder == sortOrderAsc {
 		orderStr = "ASC"
 	}
 
-	rank := "rank, "
-	if !includeRank {
-		rank = ""
+	var tieBreak string
+	if rank != "" {
+		tieBreak = ", " + rank
+		rank += ", "
 	}
 
 	switch s.current.Org.SortMode {
 	case SortModeProject:
-		return fmt.Sprintf("projects.name %s", orderStr)
+		return fmt.Sprintf("projects.name %s", orderStr) + tieBreak
 	case SortModeTitle:
-		return fmt.Sprintf("issues.title %s", orderStr)
+		return fmt.Sprintf("issues.title %s", orderStr) + tieBreak
 	case SortModeAssignee:
-		return fmt.Sprintf("users.display_name %s", orderStr)
+		return fmt.Sprintf("users.display_name %s", orderStr) + tieBreak
 	case SortModeState:
-		return fmt.Sprintf("states.name %s", orderStr)
+		return fmt.Sprintf("states.name %s", orderStr) + tieBreak
 	case SortModePrio:
-		return fmt.Sprintf("issues.priority != 0 DESC, issues.priority %s", orderStr)
+		return fmt.Sprintf("issues.priority != 0 DESC, issues.priority %s", orderStr) + tieBreak
 	case SortModeAge:
-		return fmt.Sprintf("created_at %s", orderStr)
+		return fmt.Sprintf("created_at %s", orderStr) + tieBreak
 	case SortModeTeam:
-		return fmt.Sprintf("teams.name %s", orderStr)
+		return fmt.Sprintf("teams.name %s", orderStr) + tieBreak
+	case SortModeMilestone:
+		return fmt.Sprintf("project_milestones.id IS NULL ASC, project_milestones.sort_order %s", orderStr) + tieBreak
 	default:
 		return rank + `
 			(states.name = 'Done' OR states.name = 'Canceled') ASC,
@@ -1140,38 +1679,63 @@ func (s *Store) getProjectFilter() string {
 	if s.current.Project == nil {
 		return ""
 	}
+	if s.current.Milestone != nil {
+		return fmt.Sprintf(
+			"issues.project_id = '%s' AND issues.project_milestone_id = '%s' AND",
+			s.current.Project.ID,
+			s.current.Milestone.ID,
+		)
+	}
 	return fmt.Sprintf("issues.project_id = '%s' AND", s.current.Project.ID)
 }
 
-func (s *Store) getIssueFilter(issueIDs ...string) (string, []any, error) {
-	if len(issueIDs) == 0 {
-		return "TRUE AND", nil, nil
+func (s *Store) getArchiveFilter() string {
+	if s.current.Archive {
+		return "issues.archived_at IS NOT NULL AND"
 	}
-	return sqlx.In("issues.id IN (?) AND", issueIDs)
+	return "issues.archived_at IS NULL AND"
 }
 
-func (s *Store) updateSearchIndex() error {
-	tx, err := s.db.Begin()
-	if err != nil {
-		return fmt.Errorf("failed to begin updating search indices: %w", err)
+func (s *Store) getQueryFilter() (string, []any) {
+	query := s.current.Query
+	if query == nil || len(query.where) == 0 {
+		return "", nil
 	}
-	defer tx.Rollback()
+	return strings.Join(query.where, " AND ") + " AND", query.args
+}
 
-	_, err = tx.Exec(`
-		DELETE FROM search
-		WHERE id IN (
-			SELECT id
-			FROM issues
-			WHERE issues.org_id = (SELECT id FROM orgs WHERE orgs.active = TRUE) AND
-				updated_at >= (SELECT synced_at FROM orgs WHERE active = TRUE) OR
-				canceled_at >= (SELECT synced_at FROM orgs WHERE active = TRUE)
-		);
-	`)
+func (s *Store) getCustomViewFilter() (string, []any) {
+	view := s.current.CustomView
+	if view == nil {
+		return "", nil
+	}
+
+	var teamFilter string
+	var args []any
+	if view.TeamID != "" {
+		teamFilter = "issues.team_id = ? AND"
+		args = append(args, view.TeamID)
+	}
+
+	where, filterArgs, err := translateIssueFilter(view.FilterData)
 	if err != nil {
-		return fmt.Errorf("failed to delete updated issues from search indices: %w", err)
+		args = append(args, view.ID)
+		return teamFilter + " issues.id IN (SELECT issue_id FROM custom_view_issue WHERE custom_view_id = ?) AND", args
 	}
 
-	_, err = tx.Exec(`
+	return fmt.Sprintf("%s (%s) AND", teamFilter, where), append(args, filterArgs...)
+}
+
+func (s *Store) getIssueFilter(issueIDs ...string) (string, []any, error) {
+	if len(issueIDs) == 0 {
+		return "TRUE AND", nil, nil
+	}
+	return sqlx.In("issues.id IN (?) AND", issueIDs)
+}
+
+// searchIndexInsert indexes the issues matching the where clause it's
+// formatted with.
+const searchIndexInsert = `
 		WITH json_labels AS (
 			SELECT issue_id, group_concat(labels.name, ' ') as labels
 			FROM issue_label
@@ -1181,7 +1745,7 @@ func (s *Store) updateSearchIndex() error {
 		INSERT INTO search (id, title, description, state, project, team, assignee, labels)
 		SELECT issues.id, 
 			title, 
-			description, 
+			issues.description, 
 			states.name,
 			projects.name, 
 			teams.name, 
@@ -1194,13 +1758,36 @@ func (s *Store) updateSearchIndex() error {
 		LEFT JOIN teams ON issues.team_id = teams.id
 		LEFT JOIN states ON issues.state_id = states.id
 		LEFT JOIN json_labels ON json_labels.issue_id = issues.id
-		WHERE orgs.active = TRUE AND
-			(
-				updated_at >= orgs.synced_at OR
-				canceled_at >= orgs.synced_at OR
-				created_at >= orgs.synced_at
-			);
+		WHERE %s`
+
+func (s *Store) updateSearchIndex() error {
+	tx, err := s.db.Begin()
+	if err != nil {
+		return fmt.Errorf("failed to begin updating search indices: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		DELETE FROM search
+		WHERE id IN (
+			SELECT id
+			FROM issues
+			WHERE issues.org_id = (SELECT id FROM orgs WHERE orgs.active = TRUE) AND
+				updated_at >= (SELECT synced_at FROM orgs WHERE active = TRUE) OR
+				canceled_at >= (SELECT synced_at FROM orgs WHERE active = TRUE)
+		);
 	`)
+	if err != nil {
+		return fmt.Errorf("failed to delete updated issues from search indices: %w", err)
+	}
+
+	_, err = tx.Exec(fmt.Sprintf(searchIndexInsert, `
+		orgs.active = TRUE AND issues.archived_at IS NULL AND (
+			updated_at >= orgs.synced_at OR
+			canceled_at >= orgs.synced_at OR
+			created_at >= orgs.synced_at
+		)`,
+	))
 	if err != nil {
 		return fmt.Errorf("failed to insert updated issues into search indices: %w", err)
 	}
@@ -0,0 +1,75 @@
+package store
+
+import (
+	"fmt"
+)
+
+// TableColumn is a column of the issue table as laid out by the user, with
+// the share of the width it takes.
+type TableColumn struct {
+	Name  string
+	Width float64
+}
+
+// TableColumns returns the columns laid out for view in the active org, in
+// the order they're shown. It's empty when the view was never laid out.
+func (s *Store) TableColumns(view string) ([]TableColumn, error) {
+	if s.current.Org.ID == "" {
+		return nil, ErrNoOrgSelected
+	}
+
+	var cols []TableColumn
+	err := s.db.Select(&cols, fmt.Sprintf(`
+		SELECT name, width
+		FROM table_columns
+		WHERE view = ? AND org_id = %s
+		ORDER BY position`, currentOrg),
+		view,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't select table columns: %w", err)
+	}
+
+	return cols, nil
+}
+
+// SetTableColumns replaces the columns laid out for view in the active org,
+// no columns resets the view to its default layout.
+func (s *Store) SetTableColumns(view string, cols []TableColumn) error {
+	if s.current.Org.ID == "" {
+		return ErrNoOrgSelected
+	}
+
+	tx, err := s.db.Beginx()
+	if err != nil {
+		return fmt.Errorf("couldn't begin storing table columns: %w", err)
+	}
+	defer tx.Rollback()
+
+	_, err = tx.Exec(fmt.Sprintf(`
+		DELETE FROM table_columns
+		WHERE view = ? AND org_id = %s`, currentOrg),
+		view,
+	)
+	if err != nil {
+		return fmt.Errorf("couldn't delete table columns: %w", err)
+	}
+
+	for i, col := range cols {
+		_, err = tx.Exec(fmt.Sprintf(`
+			INSERT INTO table_columns (view, position, name, width, org_id)
+			VALUES (?, ?, ?, ?, %s)`, currentOrg),
+			view, i, col.Name, col.Width,
+		)
+		if err != nil {
+			return fmt.Errorf("couldn't store table column %s: %w", col.Name, err)
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return fmt.Errorf("couldn't commit table columns: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package hover
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
+)
+
+const activityTimeFormat = "02 Jan 15:04"
+
+// IssueActivity is what happened on an issue, nil comments and history mean
+// they're still being fetched.
+type IssueActivity struct {
+	Comments []store.Comment
+	History  []store.Change
+}
+
+// IssueDetail is all there is about the issue laid out in width, its fields,
+// description, comments and history, for the detail pane to scroll through.
+func IssueDetail(issue store.Issue, activity *IssueActivity, th theme.Theme, width int, focus bool) string {
+	render := func(t text.Focusable) string {
+		if focus {
+			return t.Focused()
+		}
+		return t.Blurred()
+	}
+
+	heading := func(s string) string {
+		return lipgloss.NewStyle().Padding(1, 2, 0).Render(
+			render(text.Colored(s, color.Focusable(th.Label, th.Dim), text.B)),
+		)
+	}
+	muted := func(s string) string {
+		return render(text.Colored(s, color.Focusable(th.Muted, th.Faint)))
+	}
+	padded := lipgloss.NewStyle().Padding(0, 2).Width(width).Render
+
+	sections := []string{
+		issueSummary(issue, th, focus),
+		Description(issue, th, width-4),
+	}
+
+	if activity == nil {
+		sections = append(sections, heading("activity"), padded(muted("fetching...")))
+		return lipgloss.JoinVertical(lipgloss.Left, sections...)
+	}
+
+	sections = append(sections, heading(fmt.Sprintf("comments (%d)", len(activity.Comments))))
+	if len(activity.Comments) == 0 {
+		sections = append(sections, padded(muted("No comments")))
+	}
+	for _, comment := range activity.Comments {
+		author := comment.Author
+		if author == "" {
+			author = "unknown"
+		}
+		sections = append(sections,
+			padded(render(text.Colored(author, color.Focusable(th.Subtext, th.Blurred), text.B))+muted("  "+comment.CreatedAt.Local().Format(activityTimeFormat))),
+			markdown("comment:"+comment.ID, comment.CreatedAt, comment.Body, th, width-4),
+		)
+	}
+
+	sections = append(sections, heading("history"))
+	if len(activity.History) == 0 {
+		sections = append(sections, padded(muted("No changes")))
+	}
+	for _, change := range activity.History {
+		sections = append(sections, padded(changeText(change, th, render)+muted("  "+change.CreatedAt.Local().Format(activityTimeFormat))))
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// changeText reads like "ana moved state from Todo to In Progress".
+func changeText(change store.Change, th theme.Theme, render func(text.Focusable) string) string {
+	actor := change.Actor
+	if actor == "" {
+		actor = "linear"
+	}
+
+	subtext := func(s string) string {
+		return render(text.Colored(s, color.Focusable(th.Subtext, th.Blurred)))
+	}
+	secondary := func(s string) string {
+		return render(text.Colored(s, color.Focusable(th.Secondary, th.Dim)))
+	}
+
+	var parts []string
+	switch {
+	case change.Field == "labels":
+		if change.To != "" {
+			parts = append(parts, secondary("added labels"), subtext(change.To))
+		}
+		if change.From != "" {
+			parts = append(parts, secondary("removed labels"), subtext(change.From))
+		}
+	case change.Field == "title":
+		parts = append(parts, secondary("renamed it to"), subtext(change.To))
+	case change.From == "":
+		parts = append(parts, secondary("set "+change.Field+" to"), subtext(change.To))
+	case change.To == "":
+		parts = append(parts, secondary("cleared "+change.Field), subtext(change.From))
+	default:
+		parts = append(parts, secondary("moved "+change.Field+" from"), subtext(change.From), secondary("to"), subtext(change.To))
+	}
+
+	return strings.Join(append([]string{subtext(actor)}, parts...), " ")
+}
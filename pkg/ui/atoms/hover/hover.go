@@ -1,16 +1,38 @@
 package hover
 
 import (
+	"fmt"
+	"strings"
 	"time"
 
-	"github.com/charmbracelet/glamour"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 )
 
-func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
+func HoverIssue(issue store.Issue, th theme.Theme, width, maxHeight int, focus bool) string {
+	maxH := lipgloss.NewStyle().MaxHeight(maxHeight - 5).Render
+
+	s := lipgloss.
+		NewStyle().
+		Width(width).
+		Padding(1, 1, 0).
+		Border(lipgloss.ThickBorder()).
+		BorderForeground(color.Terminal(th.Border)).
+		Render
+
+	return s(maxH(lipgloss.JoinVertical(
+		lipgloss.Left,
+		issueSummary(issue, th, focus),
+		Description(issue, th, width-8),
+	)))
+}
+
+// issueSummary is the title, labels and fields of the issue with the commits
+// mentioning it.
+func issueSummary(issue store.Issue, th theme.Theme, focus bool) string {
 	const (
 		labelProject   = "project:      "
 		labelTeam      = "team:         "
@@ -20,7 +42,7 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 	)
 
 	label := func(s string) string {
-		t := text.Colored(s, color.Focusable("#aaa", "#444"))
+		t := text.Colored(s, color.Focusable(th.Label, th.Dim))
 
 		if focus {
 			return t.Focused()
@@ -32,7 +54,7 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 	colored := func(s string, c string, placeholder string, opts ...text.Opt) string {
 		t := text.Colored(s, color.Simple(c), opts...)
 		if s == "" {
-			t = text.Colored(placeholder, color.Simple("#888"), opts...)
+			t = text.Colored(placeholder, color.Simple(th.Blurred), opts...)
 		}
 		if focus {
 			return t.Focused()
@@ -51,9 +73,9 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 		}
 	}
 
-	assigneeColor := "#888"
+	assigneeColor := th.Blurred
 	if issue.Assignee.IsMe {
-		assigneeColor = "#76946A"
+		assigneeColor = th.Positive
 	}
 
 	var labels []text.Focusable
@@ -61,8 +83,8 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 	for _, label := range issue.Labels {
 		labels = append(labels, text.Chip(
 			label.Name,
-			color.Focusable("#eee", "#888"),
-			color.Focusable(label.Color, "#444").Darken(0.5),
+			color.Focusable(th.Text, th.Blurred),
+			color.Focusable(label.Color, th.Dim).Darken(0.5),
 		))
 	}
 
@@ -78,29 +100,148 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 
 	topBar := lipgloss.JoinVertical(
 		lipgloss.Left,
-		chip(issue.State.Name, "#222", issue.State.Color)+" "+colored(issue.Title, "#eee", "No state", text.B),
+		chip(issue.State.Name, th.Inverted, issue.State.Color)+" "+colored(issue.Title, th.Text, "No state", text.B),
 		labelsStr,
-		label(labelProject)+colored(issue.Project.Name, issue.Project.Color, "No project"),
-		label(labelTeam)+colored(issue.Team.Name, issue.Team.Color, "No team"),
+		label(labelProject)+colored(issue.Project.Name, th.Legible(issue.Project.Color), "No project"),
+		label(labelTeam)+colored(issue.Team.Name, th.Legible(issue.Team.Color), "No team"),
 		label(labelAssignee)+colored(issue.Assignee.DisplayName, assigneeColor, "No assignee"),
-		label(labelCreatedAt)+colored(issue.CreatedAt.Format(time.RFC822), "#ddd", ""),
-		label(labelUpdatedAt)+colored(issue.UpdatedAt.Format(time.RFC822), "#ddd", ""),
+		label(labelCreatedAt)+colored(issue.CreatedAt.Format(time.RFC822), th.Subtext, ""),
+		label(labelUpdatedAt)+colored(issue.UpdatedAt.Format(time.RFC822), th.Subtext, ""),
 	)
 
-	topBar = lipgloss.NewStyle().
+	if len(issue.Commits) > 0 {
+		const maxCommits = 5
+
+		commits := []string{"", label("commits:")}
+		for i, commit := range issue.Commits {
+			if i == maxCommits {
+				commits = append(commits, colored(fmt.Sprintf("  +%d more", len(issue.Commits)-maxCommits), th.Blurred, ""))
+				break
+			}
+			commits = append(commits, fmt.Sprintf(
+				"  %s %s %s",
+				colored(commit.SHA[:min(7, len(commit.SHA))], th.Accent, ""),
+				colored(commit.Subject, th.Subtext, ""),
+				colored(fmt.Sprintf("(%s@%s, %s)", commit.Repo, commit.Branch, commit.AuthoredAt.Format(time.DateOnly)), th.Blurred, ""),
+			))
+		}
+
+		topBar = lipgloss.JoinVertical(lipgloss.Left, append([]string{topBar}, commits...)...)
+	}
+
+	return lipgloss.NewStyle().
 		Padding(0, 2).Render(topBar)
+}
 
-	var description string
+func projectHealthText(health string, th theme.Theme) (string, string) {
+	switch health {
+	case "onTrack":
+		return "on track", th.Positive
+	case "atRisk":
+		return "at risk", th.Warning
+	case "offTrack":
+		return "off track", th.Negative
+	default:
+		return "", th.Blurred
+	}
+}
 
-	if issue.Description != "" {
-		r, _ := glamour.NewTermRenderer(
-			glamour.WithAutoStyle(),
-			glamour.WithWordWrap(width-8),
-		)
+func ProjectHealthColor(health string, th theme.Theme) string {
+	_, c := projectHealthText(health, th)
+	return c
+}
+
+func progressBar(progress float64, width int, fg, bg string) string {
+	progress = min(max(progress, 0), 1)
+	filled := int(progress * float64(width))
+
+	return text.Colored(strings.Repeat("━", filled), color.Simple(fg)).Focused() +
+		text.Colored(strings.Repeat("━", width-filled), color.Simple(bg)).Focused()
+}
+
+func HoverProject(project store.Project, th theme.Theme, width, maxHeight int, focus bool) string {
+	const (
+		labelState      = "state:        "
+		labelHealth     = "health:       "
+		labelLead       = "lead:         "
+		labelStartDate  = "start date:   "
+		labelTargetDate = "target date:  "
+		labelProgress   = "progress:     "
+		labelMilestones = "milestones:   "
+	)
+
+	label := func(s string) string {
+		t := text.Colored(s, color.Focusable(th.Label, th.Dim))
+
+		if focus {
+			return t.Focused()
+		} else {
+			return t.Blurred()
+		}
+	}
+
+	colored := func(s string, c string, placeholder string, opts ...text.Opt) string {
+		t := text.Colored(s, color.Simple(c), opts...)
+		if s == "" {
+			t = text.Colored(placeholder, color.Simple(th.Blurred), opts...)
+		}
+		if focus {
+			return t.Focused()
+		} else {
+			return t.Blurred()
+		}
+	}
 
-		description, _ = r.Render(issue.Description)
+	date := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format("02 Jan 2006")
 	}
 
+	health, healthColor := projectHealthText(project.Health, th)
+
+	var milestones []string
+	for i, milestone := range project.Milestones {
+		prefix := label(labelMilestones)
+		if i > 0 {
+			prefix = label(strings.Repeat(" ", len(labelMilestones)))
+		}
+		name := colored(milestone.Name, th.Subtext, "")
+		if milestone.TargetDate != nil {
+			name += colored(" ⟩ "+date(milestone.TargetDate), th.Blurred, "")
+		}
+		milestones = append(milestones, prefix+name)
+	}
+	if len(milestones) == 0 {
+		milestones = append(milestones, label(labelMilestones)+colored("", th.Subtext, "No milestones"))
+	}
+
+	progressBarWidth := max(min(width-len(labelProgress)-14, 40), 10)
+	progress := progressBar(project.Progress, progressBarWidth, th.Legible(project.Color), th.Selection) +
+		colored(fmt.Sprintf(" %d%%", int(project.Progress*100)), th.Subtext, "")
+
+	topBar := lipgloss.JoinVertical(
+		lipgloss.Left,
+		append([]string{
+			colored(project.Name, th.Legible(project.Color), "No project", text.B),
+			"",
+			label(labelState) + colored(project.State, th.Subtext, "No state"),
+			label(labelHealth) + colored(health, healthColor, "No updates"),
+			label(labelLead) + colored(project.LeadName, th.Subtext, "No lead"),
+			label(labelStartDate) + colored(date(project.StartDate), th.Subtext, "Not set"),
+			label(labelTargetDate) + colored(date(project.TargetDate), th.Subtext, "Not set"),
+			label(labelProgress) + progress,
+		}, milestones...)...,
+	)
+
+	topBar = lipgloss.NewStyle().
+		Padding(0, 2).Render(topBar)
+
+	// NOTE: projects have no revision, they're rendered again when their
+	// description changes
+	description := markdown(project.ID+project.Description, time.Time{}, project.Description, th, width-8)
+
 	maxH := lipgloss.NewStyle().MaxHeight(maxHeight - 5).Render
 
 	s := lipgloss.
@@ -108,7 +249,7 @@ func HoverIssue(issue store.Issue, width, maxHeight int, focus bool) string {
 		Width(width).
 		Padding(1, 1, 0).
 		Border(lipgloss.ThickBorder()).
-		BorderForeground(lipgloss.Color("#444")).
+		BorderForeground(color.Terminal(th.Border)).
 		Render
 
 	return s(maxH(lipgloss.JoinVertical(
@@ -0,0 +1,92 @@
+package hover
+
+import (
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
+)
+
+// maxRendered is how much markdown is kept rendered before starting over.
+const maxRendered = 256
+
+type rendererKey struct {
+	style string
+	width int
+}
+
+type renderedKey struct {
+	id       string
+	revision time.Time
+	rendererKey
+}
+
+// renderers are slow to make and markdown slow to render, so both are kept
+// around: a renderer for every style and width, and what it rendered for
+// every revision of what was rendered.
+var (
+	markdownMu sync.Mutex
+	renderers  = make(map[rendererKey]*glamour.TermRenderer)
+	rendered   = make(map[renderedKey]string)
+)
+
+func markdownStyle(th theme.Theme) string {
+	if color.NoColor() {
+		return "notty"
+	}
+	return th.Markdown
+}
+
+// markdown is source rendered in width, the one rendered before if the
+// revision of id didn't change since.
+func markdown(id string, revision time.Time, source string, th theme.Theme, width int) string {
+	if source == "" {
+		return ""
+	}
+
+	markdownMu.Lock()
+	defer markdownMu.Unlock()
+
+	key := renderedKey{
+		id:          id,
+		revision:    revision,
+		rendererKey: rendererKey{style: markdownStyle(th), width: width},
+	}
+	if out, ok := rendered[key]; ok {
+		return out
+	}
+
+	r, ok := renderers[key.rendererKey]
+	if !ok {
+		var err error
+		r, err = glamour.NewTermRenderer(
+			glamour.WithStandardStyle(key.style),
+			glamour.WithWordWrap(width),
+		)
+		if err != nil {
+			return source
+		}
+		renderers[key.rendererKey] = r
+	}
+
+	out, err := r.Render(source)
+	if err != nil {
+		return source
+	}
+
+	if len(rendered) >= maxRendered {
+		clear(rendered)
+	}
+	rendered[key] = out
+
+	return out
+}
+
+// Description is the description of the issue rendered in width, once for
+// every revision of the issue.
+func Description(issue store.Issue, th theme.Theme, width int) string {
+	return markdown(issue.ID, issue.UpdatedAt, issue.Description, th, width)
+}
@@ -61,12 +61,18 @@ func (c Color) Brighten(percent float64) Color {
 	return res
 }
 
-func (c *Color) Focused() lipgloss.Color {
-	return lipgloss.Color(c.focused)
+func (c *Color) Focused() lipgloss.TerminalColor {
+	return Terminal(c.focused)
 }
 
-func (c *Color) Blurred() lipgloss.Color {
-	return lipgloss.Color(c.blurred)
+func (c *Color) Blurred() lipgloss.TerminalColor {
+	return Terminal(c.blurred)
+}
+
+// ReadableOn is c brightened or darkened until it meets the WCAG AA contrast
+// for text on bg.
+func (c Color) ReadableOn(bg Color) Color {
+	return Focusable(Legible(c.focused, bg.focused, 4.5), Legible(c.blurred, bg.blurred, 4.5))
 }
 
 func Simple(color string) Color {
@@ -159,3 +165,33 @@ func expandHexColor(shortHex string) string {
 
 	return fmt.Sprintf("#%s%s%s", r, g, b)
 }
+
+// Mix moves color from towards color to, weight 0 is from and 1 is to.
+func Mix(weight float64, from, to string) (string, error) {
+	if weight < 0 || weight > 1 {
+		return "", fmt.Errorf("weight must be between 0 and 1")
+	}
+
+	if len(from) == 4 {
+		from = expandHexColor(from)
+	}
+	if len(to) == 4 {
+		to = expandHexColor(to)
+	}
+
+	res := "#"
+	for i := 1; i < 7; i += 2 {
+		a, err := strconv.ParseInt(from[i:i+2], 16, 64)
+		if err != nil {
+			return "", err
+		}
+		b, err := strconv.ParseInt(to[i:i+2], 16, 64)
+		if err != nil {
+			return "", err
+		}
+
+		res += fmt.Sprintf("%02x", int64(float64(a)+float64(b-a)*weight))
+	}
+
+	return res, nil
+}
@@ -0,0 +1,206 @@
+package color
+
+import (
+	"math"
+	"strconv"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var (
+	detect  sync.Once
+	profile termenv.Profile
+	noColor bool
+)
+
+func detected() termenv.Profile {
+	detect.Do(func() {
+		profile = lipgloss.ColorProfile()
+
+		noColor = termenv.EnvNoColor()
+		if noColor {
+			profile = termenv.Ascii
+			// NOTE: lipgloss drops bold and underline along with the colors on
+			// ascii, they're the only cues left without colors though
+			lipgloss.SetColorProfile(termenv.ANSI)
+		}
+	})
+
+	return profile
+}
+
+// SetProfile overrides the color profile detected from the terminal.
+func SetProfile(p termenv.Profile) {
+	detected()
+	profile = p
+	noColor = p == termenv.Ascii
+	if noColor {
+		lipgloss.SetColorProfile(termenv.ANSI)
+	} else {
+		lipgloss.SetColorProfile(p)
+	}
+}
+
+// NoColor reports whether colors are off, through NO_COLOR or a terminal
+// without any. Whatever colors tell has to be told with bold, underline or
+// reverse then.
+func NoColor() bool {
+	detected()
+	return noColor
+}
+
+// Terminal is the hex color as the terminal can show it.
+func Terminal(hex string) lipgloss.TerminalColor {
+	switch detected() {
+	case termenv.Ascii:
+		return lipgloss.NoColor{}
+	case termenv.ANSI:
+		c, ok := ansi(hex)
+		if !ok {
+			return lipgloss.Color(hex)
+		}
+		return lipgloss.ANSIColor(c)
+	default:
+		// NOTE: termenv picks the closest of the 256 colors itself
+		return lipgloss.Color(hex)
+	}
+}
+
+// ansi quantizes hex to one of the 16 colors. termenv goes through the 256
+// colors for it and lands on black for most of our dark grays, here grays
+// go by lightness and the rest by hue.
+func ansi(hex string) (uint, bool) {
+	r, g, b, ok := rgb(hex)
+	if !ok {
+		return 0, false
+	}
+
+	h, s, l := hsl(r, g, b)
+
+	if s < 0.12 || l < 0.08 || l > 0.92 {
+		switch {
+		case l < 0.12:
+			return 0, true
+		case l < 0.45:
+			return 8, true
+		case l < 0.8:
+			return 7, true
+		default:
+			return 15, true
+		}
+	}
+
+	// red, yellow, green, cyan, blue and magenta are 60 degrees apart
+	hues := []uint{1, 3, 2, 6, 4, 5}
+	c := hues[int(math.Round(h/60))%6]
+	if l > 0.55 {
+		c += 8
+	}
+
+	return c, true
+}
+
+func rgb(hex string) (r, g, b float64, ok bool) {
+	if len(hex) == 4 && hex[0] == '#' {
+		hex = expandHexColor(hex)
+	}
+	if len(hex) != 7 || hex[0] != '#' {
+		return 0, 0, 0, false
+	}
+
+	v, err := strconv.ParseUint(hex[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+
+	return float64(v>>16&0xff) / 255, float64(v>>8&0xff) / 255, float64(v&0xff) / 255, true
+}
+
+func hsl(r, g, b float64) (h, s, l float64) {
+	hi := max(r, g, b)
+	lo := min(r, g, b)
+
+	l = (hi + lo) / 2
+	if hi == lo {
+		return 0, 0, l
+	}
+
+	d := hi - lo
+	if l > 0.5 {
+		s = d / (2 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+
+	switch hi {
+	case r:
+		h = math.Mod((g-b)/d+6, 6)
+	case g:
+		h = (b-r)/d + 2
+	default:
+		h = (r-g)/d + 4
+	}
+
+	return h * 60, s, l
+}
+
+// luminance is the relative luminance of WCAG.
+func luminance(r, g, b float64) float64 {
+	linear := func(c float64) float64 {
+		if c <= 0.03928 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+
+	return 0.2126*linear(r) + 0.7152*linear(g) + 0.0722*linear(b)
+}
+
+// Contrast is the WCAG contrast ratio of two hex colors, from 1 to 21.
+func Contrast(a, b string) float64 {
+	ar, ag, ab, ok := rgb(a)
+	if !ok {
+		return 21
+	}
+	br, bg, bb, ok := rgb(b)
+	if !ok {
+		return 21
+	}
+
+	la, lb := luminance(ar, ag, ab), luminance(br, bg, bb)
+
+	return (max(la, lb) + 0.05) / (min(la, lb) + 0.05)
+}
+
+// Legible moves fg away from bg until their contrast is at least ratio, or
+// it can't get any further. Colors that aren't hex are left as they are.
+func Legible(fg, bg string, ratio float64) string {
+	if Contrast(fg, bg) >= ratio {
+		return fg
+	}
+
+	r, g, b, ok := rgb(bg)
+	if !ok {
+		return fg
+	}
+
+	move := Brighten
+	if luminance(r, g, b) > 0.18 {
+		move = Darken
+	}
+
+	for step := 0.1; step <= 1; step += 0.1 {
+		c, err := move(step, fg)
+		if err != nil {
+			return fg
+		}
+		if Contrast(c, bg) >= ratio {
+			return c
+		}
+	}
+
+	c, _ := move(1, fg)
+	return c
+}
@@ -0,0 +1,499 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
+)
+
+// Scope is where keys are read, keys of different scopes never conflict.
+type Scope string
+
+const (
+	ScopeIssues   Scope = "issues"
+	ScopeProjects Scope = "projects"
+	ScopeViews    Scope = "views"
+	ScopeInbox    Scope = "inbox"
+	ScopeHover    Scope = "hover"
+	ScopePrompt   Scope = "prompt"
+	ScopeSort     Scope = "sort"
+	ScopeGroup    Scope = "group"
+	ScopeEdit     Scope = "edit"
+	ScopeColumns  Scope = "columns"
+	ScopeBoard    Scope = "board"
+	ScopeDetail   Scope = "detail"
+)
+
+// tables are the scopes with a table focused, table keys and the keys
+// switching views are read in all of them
+var tables = []Scope{ScopeIssues, ScopeProjects, ScopeViews, ScopeInbox}
+
+// views are the scopes a view starts in, the keys leaving it are read in all
+// of them
+var views = []Scope{ScopeIssues, ScopeProjects, ScopeViews, ScopeInbox, ScopeBoard}
+
+// SortKeys follow the sort key, they pick what to sort by.
+type SortKeys struct {
+	Project   key.Binding
+	Title     key.Binding
+	Assignee  key.Binding
+	State     key.Binding
+	Prio      key.Binding
+	Age       key.Binding
+	Team      key.Binding
+	Milestone key.Binding
+	Smart     key.Binding
+}
+
+func (k SortKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.Smart, k.Project, k.Milestone, k.Title, k.Assignee, k.State, k.Prio, k.Age, k.Team}
+}
+
+func (k SortKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// GroupKeys follow the group key, they pick what to group by.
+type GroupKeys struct {
+	State    key.Binding
+	Assignee key.Binding
+	Project  key.Binding
+	Prio     key.Binding
+	Team     key.Binding
+	Label    key.Binding
+	None     key.Binding
+}
+
+func (k GroupKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.State, k.Assignee, k.Project, k.Prio, k.Team, k.Label, k.None}
+}
+
+func (k GroupKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// EditKeys follow the edit key, they pick the field to edit.
+type EditKeys struct {
+	Project   key.Binding
+	Milestone key.Binding
+	Title     key.Binding
+	Assignee  key.Binding
+	State     key.Binding
+	Prio      key.Binding
+	Team      key.Binding
+	Labels    key.Binding
+}
+
+func (k EditKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.Project, k.Milestone, k.Title, k.Assignee, k.State, k.Prio, k.Team, k.Labels}
+}
+
+func (k EditKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+// ColumnKeys lay out the columns of the issue table while the column editor
+// is open.
+type ColumnKeys struct {
+	Up       key.Binding
+	Down     key.Binding
+	MoveUp   key.Binding
+	MoveDown key.Binding
+	Toggle   key.Binding
+	Wider    key.Binding
+	Narrower key.Binding
+	Reset    key.Binding
+	Save     key.Binding
+}
+
+func (k ColumnKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.Toggle, k.MoveUp, k.MoveDown, k.Wider, k.Narrower, k.Reset, k.Save}
+}
+
+func (k ColumnKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Up, k.Down}, k.ShortHelp()}
+}
+
+// BoardKeys move around the board and move cards between states.
+type BoardKeys struct {
+	Left      key.Binding
+	Right     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	MoveLeft  key.Binding
+	MoveRight key.Binding
+	Team      key.Binding
+}
+
+func (k BoardKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.MoveLeft, k.MoveRight, k.Team}
+}
+
+func (k BoardKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{k.Left, k.Right, k.Up, k.Down}, k.ShortHelp()}
+}
+
+// DetailKeys scroll the detail pane while it's focused.
+type DetailKeys struct {
+	Up           key.Binding
+	Down         key.Binding
+	HalfPageUp   key.Binding
+	HalfPageDown key.Binding
+	Top          key.Binding
+	Bottom       key.Binding
+}
+
+func (k DetailKeys) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.HalfPageUp, k.HalfPageDown, k.Top, k.Bottom}
+}
+
+func (k DetailKeys) FullHelp() [][]key.Binding {
+	return [][]key.Binding{k.ShortHelp()}
+}
+
+type KeyMap struct {
+	Quit              key.Binding
+	Views             key.Binding
+	Workspace         key.Binding
+	Filter            key.Binding
+	SearchRemote      key.Binding
+	GoTo              key.Binding
+	Sort              key.Binding
+	Group             key.Binding
+	Collapse          key.Binding
+	CollapseAll       key.Binding
+	Edit              key.Binding
+	BulkEdit          key.Binding
+	Hover             key.Binding
+	Open              key.Binding
+	Bookmark          key.Binding
+	Branch            key.Binding
+	StartBranch       key.Binding
+	WorkingOn         key.Binding
+	Archive           key.Binding
+	Delete            key.Binding
+	Restore           key.Binding
+	Export            key.Binding
+	SaveFilter        key.Binding
+	SavedFilters      key.Binding
+	PickSavedFilter   key.Binding
+	DeleteSavedFilter key.Binding
+	MarkRead          key.Binding
+	Snooze            key.Binding
+	Columns           key.Binding
+	Detail            key.Binding
+	FocusDetail       key.Binding
+
+	SortBy       SortKeys
+	GroupBy      GroupKeys
+	EditField    EditKeys
+	Column       ColumnKeys
+	Board        BoardKeys
+	DetailScroll DetailKeys
+
+	Table table.KeyMap
+}
+
+func binding(help, desc string, keys ...string) key.Binding {
+	return key.NewBinding(key.WithKeys(keys...), key.WithHelp(help, desc))
+}
+
+func Default() KeyMap {
+	return KeyMap{
+		Quit:              binding("q", "quit", "q"),
+		Views:             binding("tab", "next view", "tab"),
+		Workspace:         binding("W", "switch workspace", "W"),
+		Filter:            binding("/", "filter", "/"),
+		SearchRemote:      binding("ctrl+r", "search linear", "ctrl+r"),
+		GoTo:              binding("ctrl+g", "go to issue", "ctrl+g"),
+		Sort:              binding("s", "sort", "s"),
+		Group:             binding("B", "group by", "B"),
+		Collapse:          binding("z", "collapse or expand group", "z"),
+		CollapseAll:       binding("Z", "collapse other groups", "Z"),
+		Edit:              binding("e", "edit", "e"),
+		BulkEdit:          binding("E", "bulk edit", "E"),
+		Hover:             binding("K", "details", "K"),
+		Open:              binding("o", "open in browser", "o"),
+		Bookmark:          binding("b", "pin", "b"),
+		Branch:            binding("c", "check out branch", "c"),
+		StartBranch:       binding("C", "start working on branch", "C"),
+		WorkingOn:         binding("w", "working on", "w"),
+		Archive:           binding("A", "archive", "A"),
+		Delete:            binding("X", "delete", "X"),
+		Restore:           binding("R", "restore", "R"),
+		Export:            binding("y", "export", "y"),
+		SaveFilter:        binding("S", "save filter", "S"),
+		SavedFilters:      binding("F", "saved filters", "F"),
+		PickSavedFilter:   binding("1-9", "apply saved filter", "1", "2", "3", "4", "5", "6", "7", "8", "9"),
+		DeleteSavedFilter: binding("ctrl+d", "delete saved filter", "ctrl+d"),
+		MarkRead:          binding("r", "toggle read", "r"),
+		Snooze:            binding("z", "snooze", "z"),
+		Columns:           binding("L", "columns", "L"),
+		Detail:            binding("p", "detail pane", "p"),
+		FocusDetail:       binding("P", "focus detail pane", "P"),
+
+		SortBy: SortKeys{
+			Project:   binding("p", "project", "p"),
+			Title:     binding("t", "title", "t"),
+			Assignee:  binding("a", "assignee", "a"),
+			State:     binding("e", "state", "e"),
+			Prio:      binding("r", "prio", "r"),
+			Age:       binding("g", "age", "g"),
+			Team:      binding("m", "team", "m"),
+			Milestone: binding("i", "milestone", "i"),
+			Smart:     binding("s", "smart", "s"),
+		},
+		GroupBy: GroupKeys{
+			State:    binding("e", "state", "e"),
+			Assignee: binding("a", "assignee", "a"),
+			Project:  binding("p", "project", "p"),
+			Prio:     binding("r", "prio", "r"),
+			Team:     binding("m", "team", "m"),
+			Label:    binding("l", "first label", "l"),
+			None:     binding("n", "none", "n"),
+		},
+		EditField: EditKeys{
+			Project:   binding("p", "project", "p"),
+			Milestone: binding("i", "milestone", "i"),
+			Title:     binding("t", "title", "t"),
+			Assignee:  binding("a", "assignee", "a"),
+			State:     binding("e", "state", "e"),
+			Prio:      binding("r", "prio", "r"),
+			Team:      binding("m", "team", "m"),
+			Labels:    binding("l", "labels", "l"),
+		},
+		Column: ColumnKeys{
+			Up:       binding("↑/k", "up", "up", "k"),
+			Down:     binding("↓/j", "down", "down", "j"),
+			MoveUp:   binding("K", "move up", "K"),
+			MoveDown: binding("J", "move down", "J"),
+			Toggle:   binding("space", "show/hide", " "),
+			Wider:    binding("+/l", "wider", "+", "l"),
+			Narrower: binding("-/h", "narrower", "-", "h"),
+			Reset:    binding("r", "reset", "r"),
+			Save:     binding("enter", "save", "enter"),
+		},
+		Board: BoardKeys{
+			Left:      binding("←/h", "left", "left", "h"),
+			Right:     binding("→/l", "right", "right", "l"),
+			Up:        binding("↑/k", "up", "up", "k"),
+			Down:      binding("↓/j", "down", "down", "j"),
+			MoveLeft:  binding("H", "move to previous state", "H"),
+			MoveRight: binding("L", "move to next state", "L"),
+			Team:      binding("t", "next team", "t"),
+		},
+		DetailScroll: DetailKeys{
+			Up:           binding("↑/k", "up", "up", "k"),
+			Down:         binding("↓/j", "down", "down", "j"),
+			HalfPageUp:   binding("u", "½ page up", "u", "ctrl+u"),
+			HalfPageDown: binding("d", "½ page down", "d", "ctrl+d"),
+			Top:          binding("g", "top", "g"),
+			Bottom:       binding("G", "bottom", "G"),
+		},
+
+		Table: table.DefaultKeyMap(),
+	}
+}
+
+type entry struct {
+	binding *key.Binding
+	scopes  []Scope
+}
+
+// registry names every binding the way the config file refers to it.
+func (km *KeyMap) registry() map[string]entry {
+	issues := []Scope{ScopeIssues}
+
+	return map[string]entry{
+		"quit":                {&km.Quit, views},
+		"views":               {&km.Views, views},
+		"workspace":           {&km.Workspace, tables},
+		"filter":              {&km.Filter, issues},
+		"search_remote":       {&km.SearchRemote, []Scope{ScopeIssues, ScopePrompt}},
+		"go_to":               {&km.GoTo, issues},
+		"sort":                {&km.Sort, issues},
+		"group":               {&km.Group, issues},
+		"collapse":            {&km.Collapse, issues},
+		"collapse_all":        {&km.CollapseAll, issues},
+		"edit":                {&km.Edit, issues},
+		"bulk_edit":           {&km.BulkEdit, issues},
+		"hover":               {&km.Hover, []Scope{ScopeIssues, ScopeProjects, ScopeBoard}},
+		"open":                {&km.Open, []Scope{ScopeIssues, ScopeHover, ScopeInbox, ScopeBoard, ScopeDetail}},
+		"bookmark":            {&km.Bookmark, issues},
+		"branch":              {&km.Branch, issues},
+		"start_branch":        {&km.StartBranch, issues},
+		"working_on":          {&km.WorkingOn, issues},
+		"archive":             {&km.Archive, issues},
+		"delete":              {&km.Delete, issues},
+		"restore":             {&km.Restore, issues},
+		"export":              {&km.Export, issues},
+		"save_filter":         {&km.SaveFilter, issues},
+		"saved_filters":       {&km.SavedFilters, issues},
+		"pick_saved_filter":   {&km.PickSavedFilter, issues},
+		"delete_saved_filter": {&km.DeleteSavedFilter, []Scope{ScopePrompt}},
+		"mark_read":           {&km.MarkRead, []Scope{ScopeInbox}},
+		"snooze":              {&km.Snooze, []Scope{ScopeInbox}},
+		"columns":             {&km.Columns, issues},
+		"detail":              {&km.Detail, []Scope{ScopeIssues, ScopeDetail}},
+		"focus_detail":        {&km.FocusDetail, []Scope{ScopeIssues, ScopeDetail}},
+
+		"sort.project":   {&km.SortBy.Project, []Scope{ScopeSort}},
+		"sort.title":     {&km.SortBy.Title, []Scope{ScopeSort}},
+		"sort.assignee":  {&km.SortBy.Assignee, []Scope{ScopeSort}},
+		"sort.state":     {&km.SortBy.State, []Scope{ScopeSort}},
+		"sort.prio":      {&km.SortBy.Prio, []Scope{ScopeSort}},
+		"sort.age":       {&km.SortBy.Age, []Scope{ScopeSort}},
+		"sort.team":      {&km.SortBy.Team, []Scope{ScopeSort}},
+		"sort.milestone": {&km.SortBy.Milestone, []Scope{ScopeSort}},
+		"sort.smart":     {&km.SortBy.Smart, []Scope{ScopeSort}},
+
+		"group.state":    {&km.GroupBy.State, []Scope{ScopeGroup}},
+		"group.assignee": {&km.GroupBy.Assignee, []Scope{ScopeGroup}},
+		"group.project":  {&km.GroupBy.Project, []Scope{ScopeGroup}},
+		"group.prio":     {&km.GroupBy.Prio, []Scope{ScopeGroup}},
+		"group.team":     {&km.GroupBy.Team, []Scope{ScopeGroup}},
+		"group.label":    {&km.GroupBy.Label, []Scope{ScopeGroup}},
+		"group.none":     {&km.GroupBy.None, []Scope{ScopeGroup}},
+
+		"edit.project":   {&km.EditField.Project, []Scope{ScopeEdit}},
+		"edit.milestone": {&km.EditField.Milestone, []Scope{ScopeEdit}},
+		"edit.title":     {&km.EditField.Title, []Scope{ScopeEdit}},
+		"edit.assignee":  {&km.EditField.Assignee, []Scope{ScopeEdit}},
+		"edit.state":     {&km.EditField.State, []Scope{ScopeEdit}},
+		"edit.prio":      {&km.EditField.Prio, []Scope{ScopeEdit}},
+		"edit.team":      {&km.EditField.Team, []Scope{ScopeEdit}},
+		"edit.labels":    {&km.EditField.Labels, []Scope{ScopeEdit}},
+
+		"columns.up":        {&km.Column.Up, []Scope{ScopeColumns}},
+		"columns.down":      {&km.Column.Down, []Scope{ScopeColumns}},
+		"columns.move_up":   {&km.Column.MoveUp, []Scope{ScopeColumns}},
+		"columns.move_down": {&km.Column.MoveDown, []Scope{ScopeColumns}},
+		"columns.toggle":    {&km.Column.Toggle, []Scope{ScopeColumns}},
+		"columns.wider":     {&km.Column.Wider, []Scope{ScopeColumns}},
+		"columns.narrower":  {&km.Column.Narrower, []Scope{ScopeColumns}},
+		"columns.reset":     {&km.Column.Reset, []Scope{ScopeColumns}},
+		"columns.save":      {&km.Column.Save, []Scope{ScopeColumns}},
+
+		"board.left":       {&km.Board.Left, []Scope{ScopeBoard}},
+		"board.right":      {&km.Board.Right, []Scope{ScopeBoard}},
+		"board.up":         {&km.Board.Up, []Scope{ScopeBoard}},
+		"board.down":       {&km.Board.Down, []Scope{ScopeBoard}},
+		"board.move_left":  {&km.Board.MoveLeft, []Scope{ScopeBoard}},
+		"board.move_right": {&km.Board.MoveRight, []Scope{ScopeBoard}},
+		"board.team":       {&km.Board.Team, []Scope{ScopeBoard}},
+
+		"detail.up":             {&km.DetailScroll.Up, []Scope{ScopeDetail}},
+		"detail.down":           {&km.DetailScroll.Down, []Scope{ScopeDetail}},
+		"detail.half_page_up":   {&km.DetailScroll.HalfPageUp, []Scope{ScopeDetail}},
+		"detail.half_page_down": {&km.DetailScroll.HalfPageDown, []Scope{ScopeDetail}},
+		"detail.top":            {&km.DetailScroll.Top, []Scope{ScopeDetail}},
+		"detail.bottom":         {&km.DetailScroll.Bottom, []Scope{ScopeDetail}},
+
+		"table.up":             {&km.Table.LineUp, tables},
+		"table.down":           {&km.Table.LineDown, tables},
+		"table.half_page_up":   {&km.Table.HalfPageUp, tables},
+		"table.half_page_down": {&km.Table.HalfPageDown, tables},
+		"table.top":            {&km.Table.GotoTop, tables},
+		"table.bottom":         {&km.Table.GotoBottom, tables},
+		"table.visual":         {&km.Table.VisualMode, tables},
+	}
+}
+
+// Load overrides the default keys with the ones of the config file, keyed by
+// action like `filter` or `sort.title`. No keys unbind the action.
+func Load(overrides map[string]config.Keys) (KeyMap, error) {
+	km := Default()
+	registry := km.registry()
+
+	var errs []error
+
+	for _, name := range sortedKeys(overrides) {
+		e, ok := registry[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("unknown key action %s", name))
+			continue
+		}
+
+		keys := overrides[name]
+		e.binding.SetKeys(keys...)
+		e.binding.SetHelp(strings.Join(keys, "/"), e.binding.Help().Desc)
+		e.binding.SetEnabled(len(keys) > 0)
+	}
+
+	errs = append(errs, conflicts(registry)...)
+
+	if len(errs) > 0 {
+		return KeyMap{}, fmt.Errorf("couldn't load keys: %w", errors.Join(errs...))
+	}
+
+	return km, nil
+}
+
+// conflicts finds keys bound to more than one action of a scope.
+func conflicts(registry map[string]entry) []error {
+	type conflict struct {
+		key, a, b string
+	}
+
+	bound := make(map[Scope]map[string]string)
+	scopes := make(map[conflict][]string)
+
+	var found []conflict
+
+	for _, name := range sortedKeys(registry) {
+		e := registry[name]
+		if !e.binding.Enabled() {
+			continue
+		}
+
+		for _, scope := range e.scopes {
+			if bound[scope] == nil {
+				bound[scope] = make(map[string]string)
+			}
+
+			for _, k := range e.binding.Keys() {
+				other, ok := bound[scope][k]
+				if !ok {
+					bound[scope][k] = name
+					continue
+				}
+				if other == name {
+					continue
+				}
+
+				c := conflict{key: k, a: other, b: name}
+				if _, ok := scopes[c]; !ok {
+					found = append(found, c)
+				}
+				scopes[c] = append(scopes[c], string(scope))
+			}
+		}
+	}
+
+	var errs []error
+	for _, c := range found {
+		errs = append(errs, fmt.Errorf("%s is bound to both %s and %s in %s", c.key, c.a, c.b, strings.Join(scopes[c], ", ")))
+	}
+
+	return errs
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Matches reports whether msg is one of the keys of the bindings.
+func Matches(msg tea.KeyMsg, bindings ...key.Binding) bool {
+	return key.Matches(msg, bindings...)
+}
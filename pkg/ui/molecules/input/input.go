@@ -11,6 +11,7 @@ import (
 	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 )
 
 type Suggestion struct {
@@ -27,16 +28,17 @@ type Model struct {
 	input            textinput.Model
 	suggestions      table.Model
 	width, maxHeight int
+	theme            theme.Theme
 }
 
-func makeSuggestionRows(opts []Suggestion) []*table.Row {
+func makeSuggestionRows(opts []Suggestion, th theme.Theme) []*table.Row {
 	rows := []*table.Row{}
 
 	for _, opt := range opts {
 		var rowNormal text.Focusable
 		if opt.Color != "" {
 			if opt.Selected {
-				rowNormal = text.Chip(opt.Title, color.Simple("#fff"), color.Simple(opt.Color))
+				rowNormal = text.Chip(opt.Title, color.Simple(th.Chip), color.Simple(opt.Color))
 			} else {
 				rowNormal = text.Colored(opt.Title, color.Simple(opt.Color))
 			}
@@ -53,7 +55,7 @@ func makeSuggestionRows(opts []Suggestion) []*table.Row {
 	return rows
 }
 
-func New(maxHeight int, options bool) Model {
+func New(maxHeight int, options bool, th theme.Theme) Model {
 	var t table.Model
 
 	input := textinput.New()
@@ -61,7 +63,7 @@ func New(maxHeight int, options bool) Model {
 	if options {
 		s := table.DefaultStyles()
 
-		s.SelectedBlurred = lipgloss.NewStyle().Background(lipgloss.Color("#333")).Bold(true)
+		s.SelectedBlurred = lipgloss.NewStyle().Background(color.Terminal(th.Selection)).Bold(true).Reverse(color.NoColor())
 		s.Header = lipgloss.NewStyle().Padding(0)
 
 		t = table.New(
@@ -76,14 +78,15 @@ func New(maxHeight int, options bool) Model {
 
 	input.Prompt = " "
 	input.PlaceholderStyle = lipgloss.NewStyle().
-		Background(lipgloss.Color("#000")).
-		Foreground(lipgloss.Color("#555"))
+		Background(color.Terminal(th.Background)).
+		Foreground(color.Terminal(th.Faint))
 	input.Focus()
 
 	return Model{
 		input:       input,
 		maxHeight:   maxHeight,
 		suggestions: t,
+		theme:       th,
 	}
 }
 
@@ -115,7 +118,7 @@ func (m *Model) Suggestions() []Suggestion {
 
 func (m *Model) SetSuggestions(suggestions []Suggestion) {
 	m.options = suggestions
-	m.suggestions.SetRows(makeSuggestionRows(suggestions))
+	m.suggestions.SetRows(makeSuggestionRows(suggestions, m.theme))
 	m.suggestions.SetHeight(min(len(suggestions)+1, m.maxHeight))
 }
 
@@ -144,7 +147,7 @@ func (m *Model) filterSuggestions() {
 			available = append(available, a)
 		}
 	}
-	rows := makeSuggestionRows(available)
+	rows := makeSuggestionRows(available, m.theme)
 	m.suggestions.SetRows(rows)
 }
 
@@ -185,7 +188,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 func (m Model) View() string {
 	input := lipgloss.NewStyle().
 		Width(m.width).
-		Background(lipgloss.Color("#000")).
+		Background(color.Terminal(m.theme.Background)).
 		Render(m.input.View())
 
 	suggestions := m.suggestions.View()
@@ -208,7 +211,7 @@ func (m Model) View() string {
 		Width(m.width).
 		MarginRight(1).
 		Border(lipgloss.RoundedBorder(), true).
-		BorderForeground(lipgloss.Color("#333")).
+		BorderForeground(color.Terminal(m.theme.Selection)).
 		Render(content)
 }
 
@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/reflow/truncate"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
 )
 
@@ -90,6 +91,10 @@ type RowItem struct {
 type Row struct {
 	Identifier string
 	Items      []RowItem
+	// Header rows title the rows below them with their first item across the
+	// whole width, the cursor passes over them unless they're Selectable
+	Header     bool
+	Selectable bool
 }
 
 type Column struct {
@@ -207,8 +212,8 @@ type Styles struct {
 
 func DefaultStyles() Styles {
 	return Styles{
-		Selected:        lipgloss.NewStyle().Background(lipgloss.Color("#333")).Padding(0, 1).Bold(true),
-		SelectedBlurred: lipgloss.NewStyle().Background(lipgloss.Color("#222")).Padding(0, 1),
+		Selected:        lipgloss.NewStyle().Background(color.Terminal("#333")).Padding(0, 1).Bold(true),
+		SelectedBlurred: lipgloss.NewStyle().Background(color.Terminal("#222")).Padding(0, 1),
 		Header:          lipgloss.NewStyle().Bold(true).Padding(0, 1),
 	}
 }
@@ -484,6 +489,16 @@ func (m *Model) ColumnOffset(columnTitle string) int {
 	return offset + 1
 }
 
+// ColumnTitles lists the titles of the columns in order, untitled ones
+// included as empty strings.
+func (m *Model) ColumnTitles() []string {
+	titles := make([]string, 0, len(m.cols))
+	for _, col := range m.cols {
+		titles = append(titles, col.title.Raw())
+	}
+	return titles
+}
+
 func (m *Model) ColumnWidth(columnTitle string) int {
 	for _, col := range m.cols {
 		if col.title.Raw() == columnTitle {
@@ -547,7 +562,17 @@ func (m Model) View() string {
 }
 
 func (m Model) SelectedRow() (identifier string) {
-	if m.cursor < 0 || m.cursor >= len(m.rows) {
+	if m.cursor < 0 || m.cursor >= len(m.rows) || m.rows[m.cursor].Header {
+		return ""
+	}
+
+	return m.rows[m.cursor].Identifier
+}
+
+// SelectedHeader is the identifier of the header row under the cursor, if
+// it's on a selectable one.
+func (m Model) SelectedHeader() (identifier string) {
+	if m.cursor < 0 || m.cursor >= len(m.rows) || !m.rows[m.cursor].Header {
 		return ""
 	}
 
@@ -568,6 +593,9 @@ func (m Model) SelectedRows() (identifiers []string) {
 	var rows []string
 
 	for i := start; i <= end; i++ {
+		if m.rows[i].Header {
+			continue
+		}
 		rows = append(rows, m.rows[i].Identifier)
 	}
 
@@ -584,7 +612,7 @@ func (m Model) Columns() []*Column {
 
 func (m *Model) SetSelectedRow(identifier string) {
 	for i, r := range m.rows {
-		if r.Identifier == identifier {
+		if r.Identifier == identifier && (!r.Header || r.Selectable) {
 			m.SetCursor(i)
 			return
 		}
@@ -625,6 +653,7 @@ func (m Model) Cursor() int {
 
 func (m *Model) SetCursor(n int) {
 	if m.cursor == n {
+		m.settle(1)
 		return
 	} else if m.cursor > n {
 		m.MoveUp(m.cursor - n)
@@ -645,9 +674,30 @@ func (m *Model) SetOnMove(onMove func(identifier string) tea.Cmd) {
 	m.onMove = onMove
 }
 
+// settle moves the cursor off header rows that aren't selectable, in the
+// direction it was moving unless there's nothing to land on that way.
+func (m *Model) settle(direction int) {
+	if len(m.rows) == 0 {
+		return
+	}
+
+	m.cursor = clamp(m.cursor, 0, len(m.rows)-1)
+
+	for _, d := range []int{direction, -direction} {
+		for i := m.cursor; i >= 0 && i < len(m.rows); i += d {
+			if !m.rows[i].Header || m.rows[i].Selectable {
+				m.cursor = i
+				return
+			}
+		}
+	}
+}
+
 func (m *Model) MoveUp(n int) tea.Cmd {
 	initial := m.cursor
 	m.cursor = clamp(m.cursor-n, 0, len(m.rows)-1)
+	m.settle(-1)
+	n = max(initial-m.cursor, n)
 	if m.visualModeEnabled {
 		if !m.visualMode {
 			m.selectedRange.SetStart(m.cursor)
@@ -659,6 +709,11 @@ func (m *Model) MoveUp(n int) tea.Cmd {
 		m.start = max(clamp(m.start-n, 0, len(m.rows)-m.itemsHeight), 0)
 	}
 
+	// NOTE: the header of the rows stays in view above them
+	if m.cursor > 0 && m.cursor == m.start && m.rows[m.cursor-1].Header {
+		m.start--
+	}
+
 	if m.onMove != nil && m.cursor != initial {
 		return m.onMove(m.SelectedRow())
 	}
@@ -668,6 +723,8 @@ func (m *Model) MoveUp(n int) tea.Cmd {
 func (m *Model) MoveDown(n int) tea.Cmd {
 	initial := m.cursor
 	m.cursor = clamp(m.cursor+n, 0, len(m.rows)-1)
+	m.settle(1)
+	n = max(m.cursor-initial, n)
 	if m.visualModeEnabled {
 		if !m.visualMode {
 			m.selectedRange.SetStart(m.cursor)
@@ -750,12 +807,16 @@ func (m *Model) fill(w int, selected bool) string {
 			return lipgloss.NewStyle().
 				Background(lipgloss.Color(m.backgroundColor.Normal)).
 				Background(m.styles.Selected.GetBackground()).
+				Reverse(m.styles.Selected.GetReverse()).
+				Underline(m.styles.Selected.GetUnderline()).
 				Width(w).
 				Render(" ")
 		} else {
 			return lipgloss.NewStyle().
 				Background(lipgloss.Color(m.backgroundColor.Blurred)).
 				Background(m.styles.SelectedBlurred.GetBackground()).
+				Reverse(m.styles.SelectedBlurred.GetReverse()).
+				Underline(m.styles.SelectedBlurred.GetUnderline()).
 				Width(w).
 				Render(" ")
 		}
@@ -783,6 +844,8 @@ func (m *Model) style(str string, selected bool) string {
 				Background(m.styles.Selected.GetBackground()).
 				Bold(m.styles.Selected.GetBold()).
 				Italic(m.styles.Selected.GetItalic()).
+				Reverse(m.styles.Selected.GetReverse()).
+				Underline(m.styles.Selected.GetUnderline()).
 				String()
 		} else {
 			return lipgloss.NewStyle().
@@ -791,6 +854,8 @@ func (m *Model) style(str string, selected bool) string {
 				Background(m.styles.SelectedBlurred.GetBackground()).
 				Bold(m.styles.SelectedBlurred.GetBold()).
 				Italic(m.styles.SelectedBlurred.GetItalic()).
+				Reverse(m.styles.SelectedBlurred.GetReverse()).
+				Underline(m.styles.SelectedBlurred.GetUnderline()).
 				String()
 		}
 	}
@@ -848,6 +913,10 @@ func (m *Model) renderRow(rowID int) string {
 	r := m.styles.Header.GetPaddingRight()
 	l := m.styles.Header.GetPaddingLeft()
 
+	if m.rows[rowID].Header {
+		return m.renderHeaderRow(rowID, l, r)
+	}
+
 	s := make([]string, 0, len(m.cols))
 	for i, v := range m.rows[rowID].Items {
 		if m.cols[i].calculatedWidth <= 0 {
@@ -900,6 +969,29 @@ func (m *Model) renderRow(rowID int) string {
 	return row
 }
 
+func (m *Model) renderHeaderRow(rowID, l, r int) string {
+	var value string
+	if items := m.rows[rowID].Items; len(items) > 0 {
+		value = items[0].Normal.Focused()
+		if !m.focus {
+			value = items[0].Normal.Blurred()
+		}
+	}
+
+	curr := m.cursor == rowID
+
+	width := max(m.itemsWidth-l-r, 0)
+	value = truncate.StringWithTail(m.style(value, curr), uint(width), "…")
+
+	parts := []string{m.fill(l, curr), value}
+	if w := lipgloss.Width(value); w < width {
+		parts = append(parts, m.fill(width-w, curr))
+	}
+	parts = append(parts, m.fill(r, curr))
+
+	return m.border(lipgloss.JoinHorizontal(lipgloss.Left, parts...), curr)
+}
+
 func clamp(v, low, high int) int {
 	return min(max(v, low), high)
 }
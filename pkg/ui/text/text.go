@@ -1,6 +1,10 @@
 package text
 
 import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
 )
@@ -37,7 +41,7 @@ var Arrow = func(bgColor string, left bool) Opt {
 		arrow := lipgloss.NewStyle().
 			Foreground(s.GetBackground())
 		if bgColor != "" {
-			arrow = arrow.Background(lipgloss.Color(bgColor))
+			arrow = arrow.Background(color.Terminal(bgColor))
 		}
 		s = s.Transform(func(v string) string {
 			if left {
@@ -103,14 +107,20 @@ type chip struct {
 	blurred string
 }
 
+// Chip is value on bg, fg is moved until it's readable on bg. Without
+// colors it's reversed.
 func Chip(value string, fg, bg color.Color, opts ...Opt) colored {
+	fg = fg.ReadableOn(bg)
+
 	focused := lipgloss.NewStyle().
 		Background(bg.Focused()).
 		Foreground(fg.Focused()).
+		Reverse(color.NoColor()).
 		Padding(0, 1)
 	blurred := lipgloss.NewStyle().
 		Background(bg.Blurred()).
 		Foreground(fg.Blurred()).
+		Reverse(color.NoColor()).
 		Padding(0, 1)
 
 	for _, opt := range opts {
@@ -143,7 +153,7 @@ func KeymapText(
 	opts ...Opt,
 ) keymapText {
 	focused := lipgloss.NewStyle().Foreground(fgColor.Focused())
-	hfocused := lipgloss.NewStyle().Foreground(keymapColor.Focused())
+	hfocused := lipgloss.NewStyle().Foreground(keymapColor.Focused()).Underline(color.NoColor())
 
 	for _, opt := range opts {
 		opt(focused)
@@ -210,3 +220,85 @@ func (j joined) Focused() string {
 func (j joined) Blurred() string {
 	return j.blurred
 }
+
+// Highlighted is Colored with the beginnings of words matching one of the
+// lowercased terms marked in hl.
+func Highlighted(value string, fg, hl color.Color, terms []string) Focusable {
+	ranges := highlightRanges(value, terms)
+	if len(ranges) == 0 {
+		return Colored(value, fg)
+	}
+
+	runes := []rune(value)
+
+	render := func(fg, hl lipgloss.TerminalColor) string {
+		normal := lipgloss.NewStyle().Foreground(fg)
+		marked := lipgloss.NewStyle().Foreground(hl).Bold(true).Underline(color.NoColor())
+
+		var b strings.Builder
+		var at int
+		for _, r := range ranges {
+			if at < r[0] {
+				b.WriteString(normal.Render(string(runes[at:r[0]])))
+			}
+			b.WriteString(marked.Render(string(runes[r[0]:r[1]])))
+			at = r[1]
+		}
+		if at < len(runes) {
+			b.WriteString(normal.Render(string(runes[at:])))
+		}
+
+		return b.String()
+	}
+
+	return colored{
+		raw:     value,
+		focused: render(fg.Focused(), hl.Focused()),
+		blurred: render(fg.Blurred(), hl.Blurred()),
+	}
+}
+
+// Matches reports whether a word of value starts with one of the terms.
+func Matches(value string, terms []string) bool {
+	return len(highlightRanges(value, terms)) > 0
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// highlightRanges returns the rune ranges of the longest term starting each
+// word of value.
+func highlightRanges(value string, terms []string) [][2]int {
+	if len(terms) == 0 {
+		return nil
+	}
+
+	runes := []rune(strings.ToLower(value))
+	// NOTE: lowercasing changed the length, ranges wouldn't line up
+	if len(runes) != utf8.RuneCountInString(value) {
+		return nil
+	}
+
+	var ranges [][2]int
+	for i := 0; i < len(runes); i++ {
+		if !isWordRune(runes[i]) || (i > 0 && isWordRune(runes[i-1])) {
+			continue
+		}
+
+		var longest int
+		for _, term := range terms {
+			t := []rune(term)
+			if len(t) > longest && len(t) <= len(runes)-i && string(runes[i:i+len(t)]) == term {
+				longest = len(t)
+			}
+		}
+
+		if longest > 0 {
+			ranges = append(ranges, [2]int{i, i + longest})
+			i += longest - 1
+		}
+	}
+
+	return ranges
+}
@@ -0,0 +1,388 @@
+package theme
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+
+	"github.com/charmbracelet/glamour"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"gopkg.in/yaml.v3"
+)
+
+// Priority colors the priority of issues, from no priority to urgent.
+type Priority struct {
+	None   string `yaml:"none"`
+	Urgent string `yaml:"urgent"`
+	High   string `yaml:"high"`
+	Medium string `yaml:"medium"`
+	Low    string `yaml:"low"`
+}
+
+// Modes are the backgrounds of the mode chip in the status bar.
+type Modes struct {
+	Text    string `yaml:"text"`
+	Normal  string `yaml:"normal"`
+	Filter  string `yaml:"filter"`
+	Sort    string `yaml:"sort"`
+	Hover   string `yaml:"hover"`
+	Visual  string `yaml:"visual"`
+	Inbox   string `yaml:"inbox"`
+	Archive string `yaml:"archive"`
+}
+
+type Theme struct {
+	// glamour style descriptions are rendered with, like `dark` or `light`
+	Markdown string `yaml:"markdown"`
+
+	Text      string `yaml:"text"`
+	Subtext   string `yaml:"subtext"`
+	Label     string `yaml:"label"`
+	Header    string `yaml:"header"`
+	Secondary string `yaml:"secondary"`
+	Muted     string `yaml:"muted"`
+	Faint     string `yaml:"faint"`
+	Dim       string `yaml:"dim"`
+	// everything of a table that isn't focused
+	Blurred string `yaml:"blurred"`
+	// text on top of entity colors, like the state chip
+	Inverted string `yaml:"inverted"`
+	// text of picked suggestions, on top of their color
+	Chip string `yaml:"chip"`
+
+	Background       string `yaml:"background"`
+	Border           string `yaml:"border"`
+	Selection        string `yaml:"selection"`
+	SelectionBlurred string `yaml:"selection_blurred"`
+	SelectionBorder  string `yaml:"selection_border"`
+	HeaderBorder     string `yaml:"header_border"`
+
+	Accent    string `yaml:"accent"`
+	SortBy    string `yaml:"sort_by"`
+	EditField string `yaml:"edit_field"`
+	Highlight string `yaml:"highlight"`
+	Pin       string `yaml:"pin"`
+	Remote    string `yaml:"remote"`
+	Positive  string `yaml:"positive"`
+	Warning   string `yaml:"warning"`
+	Negative  string `yaml:"negative"`
+	Deleted   string `yaml:"deleted"`
+	Error     string `yaml:"error"`
+	// age of issues fades from fresh to old the longer they weren't updated
+	AgeFresh string `yaml:"age_fresh"`
+	AgeOld   string `yaml:"age_old"`
+
+	Priority Priority `yaml:"priority"`
+	Modes    Modes    `yaml:"modes"`
+}
+
+var Dark = Theme{
+	Markdown: "dark",
+
+	Text:      "#eee",
+	Subtext:   "#ddd",
+	Label:     "#aaa",
+	Header:    "#bbb",
+	Secondary: "#999",
+	Muted:     "#777",
+	Faint:     "#555",
+	Dim:       "#444",
+	Blurred:   "#888",
+	Inverted:  "#222",
+	Chip:      "#fff",
+
+	Background:       "#000",
+	Border:           "#444",
+	Selection:        "#333",
+	SelectionBlurred: "#222",
+	SelectionBorder:  "#2D4F67",
+	HeaderBorder:     "#3d3223",
+
+	Accent:    "#c8a35a",
+	SortBy:    "#3f98b5",
+	EditField: "#e3463b",
+	Highlight: "#E6C384",
+	Pin:       "#5fa0b8",
+	Remote:    "#938AA9",
+	Positive:  "#76946A",
+	Warning:   "#c8a35a",
+	Negative:  "#e03a43",
+	Deleted:   "#a8524b",
+	Error:     "#C34043",
+	AgeFresh:  "#78a0c9",
+	AgeOld:    "#787878",
+
+	Priority: Priority{
+		None:   "#555555",
+		Urgent: "#e03a43",
+		High:   "#d47248",
+		Medium: "#806b38",
+		Low:    "#4a4a4a",
+	},
+	Modes: Modes{
+		Text:    "#ccc",
+		Normal:  "#2D4F67",
+		Filter:  "#752822",
+		Sort:    "#82783c",
+		Hover:   "#40394d",
+		Visual:  "#406391",
+		Inbox:   "#4d6b53",
+		Archive: "#5c4a3d",
+	},
+}
+
+var Light = Theme{
+	Markdown: "light",
+
+	Text:      "#1c1c1c",
+	Subtext:   "#2e2e2e",
+	Label:     "#5a5a5a",
+	Header:    "#3a3a3a",
+	Secondary: "#555",
+	Muted:     "#666",
+	Faint:     "#999",
+	Dim:       "#888",
+	Blurred:   "#777",
+	Inverted:  "#fff",
+	Chip:      "#fff",
+
+	Background:       "#fff",
+	Border:           "#bbb",
+	Selection:        "#dde4ea",
+	SelectionBlurred: "#ececec",
+	SelectionBorder:  "#5a8bb0",
+	HeaderBorder:     "#c9b08a",
+
+	Accent:    "#9a6b12",
+	SortBy:    "#1f6f8b",
+	EditField: "#c0322a",
+	Highlight: "#b3540b",
+	Pin:       "#2b7a99",
+	Remote:    "#6a5f8a",
+	Positive:  "#4f7a3f",
+	Warning:   "#9a6b12",
+	Negative:  "#c42b33",
+	Deleted:   "#a8524b",
+	Error:     "#b3262a",
+	AgeFresh:  "#2f6ea8",
+	AgeOld:    "#6e6e6e",
+
+	Priority: Priority{
+		None:   "#999999",
+		Urgent: "#c42b33",
+		High:   "#c0562a",
+		Medium: "#8a6d1f",
+		Low:    "#8a8a8a",
+	},
+	Modes: Modes{
+		Text:    "#fff",
+		Normal:  "#3d6e91",
+		Filter:  "#a8433b",
+		Sort:    "#8a7a2a",
+		Hover:   "#6a5f80",
+		Visual:  "#4a76ad",
+		Inbox:   "#4f7a58",
+		Archive: "#7d6450",
+	},
+}
+
+// HighContrast is dark with text, cursor and accents pushed apart as far as
+// they go.
+var HighContrast = Theme{
+	Markdown: "dark",
+
+	Text:      "#fff",
+	Subtext:   "#fff",
+	Label:     "#ddd",
+	Header:    "#fff",
+	Secondary: "#ddd",
+	Muted:     "#ccc",
+	Faint:     "#aaa",
+	Dim:       "#999",
+	Blurred:   "#bbb",
+	Inverted:  "#000",
+	Chip:      "#fff",
+
+	Background:       "#000",
+	Border:           "#fff",
+	Selection:        "#005f87",
+	SelectionBlurred: "#3a3a3a",
+	SelectionBorder:  "#5fd7ff",
+	HeaderBorder:     "#ffd75f",
+
+	Accent:    "#ffd75f",
+	SortBy:    "#5fd7ff",
+	EditField: "#ff5f5f",
+	Highlight: "#ffff00",
+	Pin:       "#5fd7ff",
+	Remote:    "#d7afff",
+	Positive:  "#87ff87",
+	Warning:   "#ffd75f",
+	Negative:  "#ff5f5f",
+	Deleted:   "#ff8787",
+	Error:     "#ff5f5f",
+	AgeFresh:  "#87d7ff",
+	AgeOld:    "#bcbcbc",
+
+	Priority: Priority{
+		None:   "#aaaaaa",
+		Urgent: "#ff5f5f",
+		High:   "#ffaf5f",
+		Medium: "#ffd75f",
+		Low:    "#bcbcbc",
+	},
+	Modes: Modes{
+		Text:    "#fff",
+		Normal:  "#005f87",
+		Filter:  "#af0000",
+		Sort:    "#875f00",
+		Hover:   "#5f00af",
+		Visual:  "#0000d7",
+		Inbox:   "#005f00",
+		Archive: "#5f3a00",
+	},
+}
+
+var builtin = map[string]Theme{
+	"dark":          Dark,
+	"light":         Light,
+	"high-contrast": HighContrast,
+}
+
+// Legible is a color of linear, like the one of a project, moved until it
+// reads on the background of the theme.
+func (t Theme) Legible(c string) string {
+	return color.Legible(c, t.Background, 3)
+}
+
+var matchColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// Load is the theme named in the config, looked up in the builtin ones and
+// the ones of themes.yml. No name picks dark or light after the background
+// of the terminal.
+func Load(name string) (Theme, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return Theme{}, err
+	}
+
+	return LoadFile(filepath.Join(dir, "themes.yml"), name)
+}
+
+// LoadFile is Load reading user themes from path. Themes of the file only
+// set the colors they change, the rest come from the theme they extend: the
+// builtin one of the same name, else dark, unless `extends` says otherwise.
+func LoadFile(path, name string) (Theme, error) {
+	if name == "" {
+		if lipgloss.HasDarkBackground() {
+			return Dark, nil
+		}
+		return Light, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return Theme{}, fmt.Errorf("couldn't read themes: %w", err)
+	}
+
+	var defined map[string]yaml.Node
+	err = yaml.Unmarshal(data, &defined)
+	if err != nil {
+		return Theme{}, fmt.Errorf("couldn't parse themes: %w", err)
+	}
+
+	t, err := resolve(defined, name, nil)
+	if err != nil {
+		return Theme{}, fmt.Errorf("couldn't load theme %s: %w", name, err)
+	}
+
+	err = t.validate()
+	if err != nil {
+		return Theme{}, fmt.Errorf("couldn't load theme %s: %w", name, err)
+	}
+
+	return t, nil
+}
+
+func resolve(defined map[string]yaml.Node, name string, seen []string) (Theme, error) {
+	if slices.Contains(seen, name) {
+		return Theme{}, fmt.Errorf("%s extends itself", name)
+	}
+
+	node, ok := defined[name]
+	if !ok {
+		t, ok := builtin[name]
+		if !ok {
+			return Theme{}, fmt.Errorf("unknown theme %s", name)
+		}
+		return t, nil
+	}
+
+	var base struct {
+		Extends string `yaml:"extends"`
+	}
+	err := node.Decode(&base)
+	if err != nil {
+		return Theme{}, err
+	}
+	// NOTE: a user theme named like a builtin one tweaks it
+	if base.Extends == "" {
+		base.Extends = "dark"
+		if _, ok := builtin[name]; ok {
+			base.Extends = name
+		}
+	}
+
+	var t Theme
+	if b, ok := builtin[name]; ok && base.Extends == name {
+		t = b
+	} else {
+		t, err = resolve(defined, base.Extends, append(seen, name))
+		if err != nil {
+			return Theme{}, err
+		}
+	}
+
+	err = node.Decode(&t)
+	if err != nil {
+		return Theme{}, err
+	}
+
+	return t, nil
+}
+
+// validate checks the colors are hex, they're darkened and brightened.
+func (t Theme) validate() error {
+	var errs []error
+
+	if _, ok := glamour.DefaultStyles[t.Markdown]; !ok {
+		errs = append(errs, fmt.Errorf("unknown markdown style %s", t.Markdown))
+	}
+
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			name := prefix + field.Tag.Get("yaml")
+
+			switch f := v.Field(i); f.Kind() {
+			case reflect.Struct:
+				walk(f, name+".")
+			case reflect.String:
+				if field.Name == "Markdown" || matchColor.MatchString(f.String()) {
+					continue
+				}
+				errs = append(errs, fmt.Errorf("%s is not a hex color: %q", name, f.String()))
+			}
+		}
+	}
+	walk(reflect.ValueOf(t), "")
+
+	return errors.Join(errs...)
+}
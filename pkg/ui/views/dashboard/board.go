@@ -0,0 +1,307 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/layouts"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+)
+
+const (
+	boardColumnMinWidth = 24
+	// lines a card takes, its border included
+	boardCardHeight = 5
+)
+
+// board lays the issues of a team out in columns, one for each workflow state
+// of the team in the order linear has them in.
+type board struct {
+	team   store.Team
+	states []store.State
+	cards  [][]store.Issue
+	column int
+	row    int
+}
+
+func (b *board) card() *store.Issue {
+	if b.column >= len(b.cards) || b.row >= len(b.cards[b.column]) {
+		return nil
+	}
+	return &b.cards[b.column][b.row]
+}
+
+func (b *board) selected() string {
+	if card := b.card(); card != nil {
+		return card.ID
+	}
+	return ""
+}
+
+// clampRow keeps the cursor on a card of the column, if it has any.
+func (b *board) clampRow() {
+	if b.column >= len(b.cards) {
+		b.row = 0
+		return
+	}
+	b.row = max(min(b.row, len(b.cards[b.column])-1), 0)
+}
+
+// updateBoard deals the issues of the board team out to the states they're
+// in, keeping the cursor on the selected issue wherever it went.
+func (m *Model) updateBoard(states []store.State, issues []store.Issue, selected string) {
+	b := &m.board
+
+	if selected == "" {
+		selected = b.selected()
+	}
+
+	columns := make(map[string]int, len(states))
+	for i, state := range states {
+		columns[state.ID] = i
+	}
+
+	b.states = states
+	b.cards = make([][]store.Issue, len(states))
+	for _, issue := range issues {
+		i, ok := columns[issue.State.ID]
+		if !ok {
+			continue
+		}
+		b.cards[i] = append(b.cards[i], issue)
+	}
+
+	b.column = max(min(b.column, len(states)-1), 0)
+
+	for i, cards := range b.cards {
+		for j, card := range cards {
+			if card.ID == selected {
+				b.column, b.row = i, j
+				return
+			}
+		}
+	}
+
+	b.clampRow()
+}
+
+func (m *Model) handleBoard(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusBoard {
+		return nil
+	}
+
+	b := &m.board
+
+	switch {
+	case keymap.Matches(key, m.keys.Board.Left):
+		if b.column > 0 {
+			b.column--
+			b.clampRow()
+		}
+
+	case keymap.Matches(key, m.keys.Board.Right):
+		if b.column < len(b.states)-1 {
+			b.column++
+			b.clampRow()
+		}
+
+	case keymap.Matches(key, m.keys.Board.Up):
+		if b.row > 0 {
+			b.row--
+		}
+
+	case keymap.Matches(key, m.keys.Board.Down):
+		b.row++
+		b.clampRow()
+
+	case keymap.Matches(key, m.keys.Board.MoveLeft, m.keys.Board.MoveRight):
+		card := b.card()
+		if card == nil {
+			return nil
+		}
+		issue := *card
+
+		to := b.column + 1
+		if keymap.Matches(key, m.keys.Board.MoveLeft) {
+			to = b.column - 1
+		}
+		if to < 0 || to >= len(b.states) {
+			return nil
+		}
+		state := b.states[to]
+
+		onFail := func() tea.Msg {
+			err := m.store.StoreIssues([]store.Issue{issue})
+			if err != nil {
+				return err
+			}
+			return m.updateTables()
+		}
+
+		err := m.store.UpdateIssues(store.UpdateIssueFieldState, state.ID, issue.ID)
+		if err != nil {
+			return returnError(err)
+		}
+
+		// the cursor follows the card to its new state
+		b.column = to
+
+		return tea.Batch(
+			m.client.UpdateIssues([]string{issue.ID}, onFail, client.WithSetState(state.ID)),
+			m.updateTables(withSelectedIssue(issue.ID)),
+		)
+
+	case keymap.Matches(key, m.keys.Board.Team):
+		teams, err := m.store.Teams()
+		if err != nil {
+			return returnError(err)
+		}
+		if len(teams) < 2 {
+			return nil
+		}
+
+		next := teams[0]
+		for i, team := range teams {
+			if team.ID == b.team.ID {
+				next = teams[(i+1)%len(teams)]
+			}
+		}
+
+		b.team = next
+		b.column, b.row = 0, 0
+
+		return m.updateTables()
+	}
+
+	return nil
+}
+
+// renderBoard is the board in width and height, the columns around the
+// cursor when they don't all fit.
+func (m *Model) renderBoard(width, height int) string {
+	th := m.theme
+	b := &m.board
+
+	title := text.Joined("",
+		text.Colored(b.team.Name, color.Simple(th.Legible(b.team.Color)), text.B),
+		text.Colored(fmt.Sprintf("  %d states", len(b.states)), color.Simple(th.Muted)),
+	).Focused()
+	title = lipgloss.NewStyle().Padding(0, 1).Render(title)
+
+	if len(b.states) == 0 {
+		empty := text.Colored("no states synced for this team yet", color.Simple(th.Faint)).Focused()
+		return lipgloss.NewStyle().Height(height).Render(lipgloss.JoinVertical(lipgloss.Left, title, "", lipgloss.NewStyle().Padding(0, 1).Render(empty)))
+	}
+
+	visible := max(min(width/boardColumnMinWidth, len(b.states)), 1)
+	first := max(min(b.column-visible/2, len(b.states)-visible), 0)
+	columnWidth := width / visible
+
+	columns := make([]string, 0, visible)
+	for i := first; i < first+visible; i++ {
+		columns = append(columns, m.renderBoardColumn(i, columnWidth, height-2))
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		title,
+		"",
+		lipgloss.JoinHorizontal(lipgloss.Top, columns...),
+	)
+}
+
+// renderBoardColumn is the header of the state with how many issues are in
+// it over as many of its cards as fit, scrolled to the cursor.
+func (m *Model) renderBoardColumn(i, width, height int) string {
+	th := m.theme
+	b := &m.board
+	state := b.states[i]
+	cards := b.cards[i]
+
+	header := text.Joined("",
+		text.Colored(state.Name, color.Simple(th.Legible(state.Color)), text.B),
+		text.Colored(fmt.Sprintf("  %d", len(cards)), color.Simple(th.Muted)),
+	).Focused()
+
+	headerStyle := lipgloss.NewStyle().
+		Width(width-2).
+		Border(lipgloss.ThickBorder(), false).
+		BorderBottom(true).
+		BorderForeground(color.Terminal(th.HeaderBorder))
+	if i == b.column {
+		headerStyle = headerStyle.BorderForeground(color.Terminal(th.SelectionBorder))
+	}
+
+	lines := []string{headerStyle.Render(truncate.StringWithTail(header, uint(max(width-2, 0)), "…"))}
+
+	fit := max((height-lipgloss.Height(lines[0]))/boardCardHeight, 1)
+
+	start := 0
+	if i == b.column {
+		start = max(b.row-fit+1, 0)
+	}
+
+	for j := start; j < len(cards) && j < start+fit; j++ {
+		lines = append(lines, m.renderCard(cards[j], width-2, i == b.column && j == b.row))
+	}
+
+	return lipgloss.NewStyle().
+		Width(width).
+		Height(height).
+		Padding(0, 1, 0, 1).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (m *Model) renderCard(issue store.Issue, width int, selected bool) string {
+	th := m.theme
+
+	// the border and the padding
+	inner := max(width-4, 1)
+
+	brighten := 0.0
+	if selected {
+		brighten = 0.2
+	}
+
+	identifier := text.Colored(issue.Identifier, color.Simple(th.Muted).Brighten(brighten)).Focused()
+	prio := m.renderPrio(issue.Priority, brighten).Focused()
+
+	title := text.Colored(
+		truncate.StringWithTail(issue.Title, uint(inner), "…"),
+		color.Simple(th.Text).Brighten(brighten),
+	).Focused()
+
+	assignee := text.Colored("unassigned", color.Simple(th.Faint)).Focused()
+	if issue.Assignee.ID != "" {
+		fg := color.Simple(th.Blurred)
+		if issue.Assignee.IsMe {
+			fg = color.Simple(th.Positive)
+		}
+		assignee = text.Colored(truncate.StringWithTail(issue.Assignee.DisplayName, uint(inner), "…"), fg.Brighten(brighten)).Focused()
+	}
+
+	style := lipgloss.NewStyle().
+		Width(width-2).
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(color.Terminal(th.Border)).
+		Padding(0, 1)
+	if selected {
+		style = style.
+			BorderForeground(color.Terminal(th.SelectionBorder)).
+			Background(color.Terminal(th.Selection))
+	}
+
+	return style.Render(lipgloss.JoinVertical(
+		lipgloss.Left,
+		layouts.SpaceBetween(inner, identifier, prio),
+		title,
+		assignee,
+	))
+}
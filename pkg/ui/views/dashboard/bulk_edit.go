@@ -0,0 +1,416 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/linear/models"
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"gopkg.in/yaml.v3"
+)
+
+const bulkEditHeader = `# Edit the issues below, the changes are listed for review once the editor
+# is closed. Issues removed from the list are left untouched.
+#
+# priority is one of none, urgent, high, medium or low, an empty assignee
+# unassigns and an empty project takes the issue out of its project.
+`
+
+var prioNames = []string{"none", "urgent", "high", "medium", "low"}
+
+type bulkEditIssue struct {
+	ID       string   `yaml:"id"`
+	Title    string   `yaml:"title"`
+	Team     string   `yaml:"team"`
+	State    string   `yaml:"state"`
+	Assignee string   `yaml:"assignee"`
+	Priority string   `yaml:"priority"`
+	Project  string   `yaml:"project"`
+	Labels   []string `yaml:"labels,flow"`
+}
+
+type bulkEditedMsg struct {
+	path     string
+	issueIDs []string
+	err      error
+}
+
+// bulkChange is a single mutation shared by every issue it lists, so it can
+// be sent as one batch update.
+type bulkChange struct {
+	field    string
+	value    string
+	issueIDs []string
+	apply    func(issueIDs []string) error
+	opt      client.IssueUpdateOpt
+}
+
+func (m *Model) handleBulkEdit(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusIssues || !keymap.Matches(key, m.keys.BulkEdit) {
+		return nil
+	}
+
+	issueIDs := m.table.SelectedRows()
+	if len(issueIDs) == 0 {
+		return nil
+	}
+
+	issues, err := m.store.Issues(issueIDs...)
+	if err != nil {
+		return returnError(err)
+	}
+
+	path, err := writeBulkEdit(issues)
+	if err != nil {
+		return returnError(err)
+	}
+
+	return tea.ExecProcess(editorCommand(path), func(err error) tea.Msg {
+		return bulkEditedMsg{path: path, issueIDs: issueIDs, err: err}
+	})
+}
+
+func editorCommand(path string) *exec.Cmd {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	// NOTE: editors are often configured with flags, like `code --wait`
+	args := strings.Fields(editor)
+
+	return exec.Command(args[0], append(args[1:], path)...)
+}
+
+func writeBulkEdit(issues []store.Issue) (string, error) {
+	edits := make([]bulkEditIssue, 0, len(issues))
+	for _, issue := range issues {
+		project := issue.Project.Name
+		if project == "(No Project)" {
+			project = ""
+		}
+
+		labels := make([]string, 0, len(issue.Labels))
+		for _, label := range issue.Labels {
+			labels = append(labels, label.Name)
+		}
+
+		edits = append(edits, bulkEditIssue{
+			ID:       issue.Identifier,
+			Title:    issue.Title,
+			Team:     issue.Team.Name,
+			State:    issue.State.Name,
+			Assignee: issue.Assignee.DisplayName,
+			Priority: prioNames[issue.Priority],
+			Project:  project,
+			Labels:   labels,
+		})
+	}
+
+	data, err := yaml.Marshal(edits)
+	if err != nil {
+		return "", fmt.Errorf("couldn't encode issues: %w", err)
+	}
+
+	file, err := os.CreateTemp("", "tinear-*.yaml")
+	if err != nil {
+		return "", fmt.Errorf("couldn't create bulk edit file: %w", err)
+	}
+	defer file.Close()
+
+	_, err = file.WriteString(bulkEditHeader + "\n" + string(data))
+	if err != nil {
+		os.Remove(file.Name())
+		return "", fmt.Errorf("couldn't write bulk edit file: %w", err)
+	}
+
+	return file.Name(), nil
+}
+
+func (m *Model) bulkEdited(msg bulkEditedMsg) tea.Cmd {
+	defer os.Remove(msg.path)
+
+	if msg.err != nil {
+		return returnError(fmt.Errorf("couldn't run editor: %w", msg.err))
+	}
+
+	data, err := os.ReadFile(msg.path)
+	if err != nil {
+		return returnError(fmt.Errorf("couldn't read bulk edit file: %w", err))
+	}
+
+	var edits []bulkEditIssue
+	err = yaml.Unmarshal(data, &edits)
+	if err != nil {
+		return returnError(fmt.Errorf("couldn't parse bulk edit file: %w", err))
+	}
+
+	// NOTE: diff against the store as it is now, a sync may have run while editing
+	issues, err := m.store.Issues(msg.issueIDs...)
+	if err != nil {
+		return returnError(err)
+	}
+
+	changes, err := m.diffBulkEdit(issues, edits)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+
+	identifiers := make(map[string]string, len(issues))
+	for _, issue := range issues {
+		identifiers[issue.ID] = issue.Identifier
+	}
+
+	var details []string
+	for _, change := range changes {
+		var changed []string
+		for _, id := range change.issueIDs {
+			changed = append(changed, identifiers[id])
+		}
+		details = append(details, fmt.Sprintf("%s → %s  %s", change.field, change.value, strings.Join(changed, " ")))
+	}
+
+	title := fmt.Sprintf("apply %d changes", len(changes))
+	if len(changes) == 1 {
+		title = "apply 1 change"
+	}
+
+	return m.askConfirm(title, details, func() tea.Cmd {
+		m.table.SetVisualMode(false)
+		return m.applyBulkChanges(issues, changes)
+	})
+}
+
+func (m *Model) diffBulkEdit(issues []store.Issue, edits []bulkEditIssue) ([]bulkChange, error) {
+	byIdentifier := make(map[string]store.Issue, len(issues))
+	for _, issue := range issues {
+		byIdentifier[strings.ToUpper(issue.Identifier)] = issue
+	}
+
+	teams, err := m.store.Teams()
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := m.store.Users()
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := m.store.Projects()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make(map[string][]store.State)
+	statesOf := func(teamID string) ([]store.State, error) {
+		if _, ok := states[teamID]; !ok {
+			teamStates, err := m.store.States(teamID)
+			if err != nil {
+				return nil, err
+			}
+			states[teamID] = teamStates
+		}
+		return states[teamID], nil
+	}
+
+	labels := make(map[string][]store.Label)
+	labelsOf := func(teamID string) ([]store.Label, error) {
+		if _, ok := labels[teamID]; !ok {
+			teamLabels, err := m.store.Labels(teamID)
+			if err != nil {
+				return nil, err
+			}
+			labels[teamID] = teamLabels
+		}
+		return labels[teamID], nil
+	}
+
+	var changes []bulkChange
+	grouped := make(map[string]int)
+
+	add := func(issueID, field, key, value string, apply func([]string) error, opt client.IssueUpdateOpt) {
+		key = field + "\x00" + key
+		if i, ok := grouped[key]; ok {
+			changes[i].issueIDs = append(changes[i].issueIDs, issueID)
+			return
+		}
+		grouped[key] = len(changes)
+		changes = append(changes, bulkChange{
+			field:    field,
+			value:    value,
+			issueIDs: []string{issueID},
+			apply:    apply,
+			opt:      opt,
+		})
+	}
+
+	setField := func(field store.UpdateIssueField, value any) func([]string) error {
+		return func(issueIDs []string) error {
+			return m.store.UpdateIssues(field, value, issueIDs...)
+		}
+	}
+
+	for _, edit := range edits {
+		issue, ok := byIdentifier[strings.ToUpper(edit.ID)]
+		if !ok {
+			return nil, fmt.Errorf("%s isn't one of the issues being edited", edit.ID)
+		}
+
+		teamID := issue.Team.ID
+		if !strings.EqualFold(edit.Team, issue.Team.Name) {
+			i := slices.IndexFunc(teams, func(t store.Team) bool { return strings.EqualFold(t.Name, edit.Team) })
+			if i == -1 {
+				return nil, fmt.Errorf("%s: unknown team %q", edit.ID, edit.Team)
+			}
+			team := teams[i]
+			teamID = team.ID
+
+			add(issue.ID, "team", team.ID, team.Name, setField(store.UpdateIssueFieldTeam, team.ID), client.WithSetTeam(team.ID))
+		}
+
+		title := strings.TrimSpace(edit.Title)
+		if title == "" {
+			return nil, fmt.Errorf("%s: title can't be empty", edit.ID)
+		}
+		if title != issue.Title {
+			add(issue.ID, "title", title, fmt.Sprintf("%q", title), setField(store.UpdateIssueFieldTitle, title), client.WithSetTitle(title))
+		}
+
+		// NOTE: states belong to a team, moving teams needs the state of the new one
+		if teamID != issue.Team.ID || !strings.EqualFold(edit.State, issue.State.Name) {
+			teamStates, err := statesOf(teamID)
+			if err != nil {
+				return nil, err
+			}
+			i := slices.IndexFunc(teamStates, func(s store.State) bool { return strings.EqualFold(s.Name, edit.State) })
+			if i == -1 {
+				return nil, fmt.Errorf("%s: unknown state %q", edit.ID, edit.State)
+			}
+			state := teamStates[i]
+
+			add(issue.ID, "state", state.ID, state.Name, setField(store.UpdateIssueFieldState, state.ID), client.WithSetState(state.ID))
+		}
+
+		switch {
+		case edit.Assignee == "" && issue.Assignee.ID != "":
+			add(issue.ID, "assignee", "", "(unassigned)", setField(store.UpdateIssueFieldAssignee, nil), client.WithSetAssignee(models.NullString))
+		case edit.Assignee != "" && !strings.EqualFold(edit.Assignee, issue.Assignee.DisplayName):
+			i := slices.IndexFunc(users, func(u store.User) bool {
+				return strings.EqualFold(u.DisplayName, edit.Assignee) || strings.EqualFold(u.Name, edit.Assignee)
+			})
+			if i == -1 {
+				return nil, fmt.Errorf("%s: unknown assignee %q", edit.ID, edit.Assignee)
+			}
+			user := users[i]
+
+			add(issue.ID, "assignee", user.ID, user.DisplayName, setField(store.UpdateIssueFieldAssignee, user.ID), client.WithSetAssignee(user.ID))
+		}
+
+		prio := slices.IndexFunc(prioNames, func(name string) bool { return strings.EqualFold(name, edit.Priority) })
+		if prio == -1 {
+			return nil, fmt.Errorf("%s: unknown priority %q", edit.ID, edit.Priority)
+		}
+		if store.Prio(prio) != issue.Priority {
+			add(issue.ID, "priority", prioNames[prio], prioNames[prio], setField(store.UpdateIssueFieldPrio, prio), client.WithSetPrio(int64(prio)))
+		}
+
+		projectName := edit.Project
+		if projectName == "" {
+			projectName = "(No Project)"
+		}
+		if !strings.EqualFold(projectName, issue.Project.Name) {
+			i := slices.IndexFunc(projects, func(p store.Project) bool { return strings.EqualFold(p.Name, projectName) })
+			if i == -1 {
+				return nil, fmt.Errorf("%s: unknown project %q", edit.ID, edit.Project)
+			}
+			project := projects[i]
+
+			opt := client.WithSetProject(project.ID)
+			if project.Name == "(No Project)" {
+				opt = client.WithSetProject(models.NullString)
+			}
+
+			add(issue.ID, "project", project.ID, project.Name, setField(store.UpdateIssueFieldProject, project.ID), opt)
+		}
+
+		teamLabels, err := labelsOf(teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		var wanted []store.Label
+		for _, name := range edit.Labels {
+			i := slices.IndexFunc(teamLabels, func(l store.Label) bool { return strings.EqualFold(l.Name, name) })
+			if i == -1 {
+				return nil, fmt.Errorf("%s: unknown label %q", edit.ID, name)
+			}
+			if !hasLabel(wanted, teamLabels[i].ID) {
+				wanted = append(wanted, teamLabels[i])
+			}
+		}
+
+		for _, label := range issue.Labels {
+			if hasLabel(wanted, label.ID) {
+				continue
+			}
+			add(issue.ID, "labels", "-"+label.ID, "-"+label.Name, func(issueIDs []string) error {
+				return m.store.UpdateIssuesLabels(store.LabelUpdateRemove, label.ID, issueIDs...)
+			}, client.WithRemoveLabels(label.ID))
+		}
+
+		for _, label := range wanted {
+			if hasLabel(issue.Labels, label.ID) {
+				continue
+			}
+			add(issue.ID, "labels", "+"+label.ID, "+"+label.Name, func(issueIDs []string) error {
+				return m.store.UpdateIssuesLabels(store.LabelUpdateAdd, label.ID, issueIDs...)
+			}, client.WithAddLabels(label.ID))
+		}
+	}
+
+	return changes, nil
+}
+
+func hasLabel(labels []store.Label, labelID string) bool {
+	return slices.ContainsFunc(labels, func(l store.Label) bool { return l.ID == labelID })
+}
+
+func (m *Model) applyBulkChanges(issues []store.Issue, changes []bulkChange) tea.Cmd {
+	if len(changes) == 0 {
+		return nil
+	}
+
+	onFail := func() tea.Msg {
+		err := m.store.StoreIssues(issues)
+		if err != nil {
+			return err
+		}
+		return m.updateTables()
+	}
+
+	var updates []tea.Cmd
+	for _, change := range changes {
+		err := change.apply(change.issueIDs)
+		if err != nil {
+			return tea.Batch(onFail, returnError(err))
+		}
+		updates = append(updates, m.client.UpdateIssues(change.issueIDs, onFail, change.opt))
+	}
+
+	// NOTE: in order, moving teams has to land before the state of the new team is set
+	return tea.Batch(m.updateTables(), tea.Sequence(updates...))
+}
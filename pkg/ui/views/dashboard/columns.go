@@ -0,0 +1,594 @@
+package dashboard
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/atoms/box"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
+)
+
+// column is a column the issue table can show, laid out by its name.
+type column struct {
+	name  string
+	title string
+	// share of the width it takes unless laid out otherwise, none is fixed
+	factor   float32
+	minWidth int
+	maxWidth int
+	fill     bool
+	// only shown in these views, all of them when empty
+	views []view
+
+	sortBy    func(keymap.KeyMap) key.Binding
+	editField func(keymap.KeyMap) key.Binding
+	render    func(m *Model, issue store.Issue, terms []string) table.RowItem
+}
+
+func (c column) fixed() bool {
+	return c.factor == 0
+}
+
+var columns = []column{
+	{
+		name: "project", title: "project", factor: 1, maxWidth: 20,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Project },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Project },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			th := m.theme
+			if issue.Project.Name == "" {
+				return table.RowItem{
+					Normal:   text.Colored("", color.Focusable(th.Faint, th.Faint)),
+					Selected: text.Colored("", color.Focusable(th.Faint, th.Faint).Brighten(0.2)),
+				}
+			}
+			fg := color.Focusable(th.Legible(issue.Project.Color), th.Blurred)
+			return table.RowItem{
+				Normal:   m.highlight(issue.Project.Name, fg, terms),
+				Selected: m.highlight(issue.Project.Name, fg.Brighten(0.2), terms),
+			}
+		},
+	},
+	{
+		name: "milestone", title: "milestone", factor: 1, maxWidth: 20,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Milestone },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Milestone },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			fg := color.Focusable(m.theme.Secondary, m.theme.Blurred)
+			return coloredItem(issue.Milestone.Name, fg)
+		},
+	},
+	{
+		name: "removed", title: "removed", factor: 1, maxWidth: 20,
+		views: []view{ViewArchive},
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			if issue.ArchivedAt == nil {
+				return coloredItem("", color.Simple(m.theme.Blurred))
+			}
+			removedText, removedColor := removedTextAndColor(issue, m.theme)
+			return coloredItem(removedText, color.Focusable(removedColor, m.theme.Blurred))
+		},
+	},
+	{
+		name: "identifier", title: "identifier", factor: 0.5, minWidth: 12, maxWidth: 12,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			fg := color.Focusable(m.theme.Secondary, m.theme.Blurred)
+			return table.RowItem{
+				Normal:   m.highlight(issue.Identifier, fg, terms),
+				Selected: m.highlight(issue.Identifier, fg.Brighten(0.2), terms),
+			}
+		},
+	},
+	{
+		name: "title", title: "title", factor: 1, fill: true,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Title },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Title },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			fg := color.Focusable(m.theme.Text, m.theme.Blurred)
+			return table.RowItem{
+				Normal:   m.highlight(issue.Title, fg.Darken(0.2), terms),
+				Selected: m.highlight(issue.Title, fg.Brighten(0.2), terms),
+			}
+		},
+	},
+	{
+		name: "pin", title: "", minWidth: 4, maxWidth: 4,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			pinnedText := ""
+			pinnedColor := color.Focusable(m.theme.Pin, m.theme.Blurred)
+			if issue.Pinned {
+				pinnedText = ""
+			} else if issue.Remote {
+				// found searching linear, it's older than what's synced
+				pinnedText = ""
+				pinnedColor = color.Focusable(m.theme.Remote, m.theme.Blurred)
+			}
+			return table.RowItem{
+				Normal:   text.Colored(pinnedText, pinnedColor, text.B),
+				Selected: text.Colored(pinnedText, pinnedColor.Brighten(0.2), text.B),
+			}
+		},
+	},
+	{
+		name: "assignee", title: "assignee", factor: 1, maxWidth: 10,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Assignee },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Assignee },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return m.userItem(issue.Assignee, terms)
+		},
+	},
+	{
+		name: "creator", title: "creator", factor: 1, minWidth: 9, maxWidth: 10,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return m.userItem(issue.Creator, nil)
+		},
+	},
+	{
+		name: "state", title: "state", factor: 1, maxWidth: 10,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.State },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.State },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			fg := color.Focusable(m.theme.Legible(issue.State.Color), m.theme.Blurred)
+			return table.RowItem{
+				Normal:   m.highlight(issue.State.Name, fg, terms),
+				Selected: m.highlight(issue.State.Name, fg.Brighten(0.2), terms),
+			}
+		},
+	},
+	{
+		name: "prio", title: "prio", factor: 0.5, maxWidth: 10,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Prio },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Prio },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return table.RowItem{
+				Normal:   m.renderPrio(issue.Priority, 0),
+				Selected: m.renderPrio(issue.Priority, 0.2),
+			}
+		},
+	},
+	{
+		name: "estimate", title: "estimate", factor: 0.5, minWidth: 10, maxWidth: 10,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			var estimate string
+			if issue.Estimate != nil {
+				estimate = strconv.FormatFloat(*issue.Estimate, 'f', -1, 64)
+			}
+			return coloredItem(estimate, color.Focusable(m.theme.Secondary, m.theme.Blurred))
+		},
+	},
+	{
+		name: "age", title: "age", factor: 0.5, maxWidth: 6,
+		sortBy: func(k keymap.KeyMap) key.Binding { return k.SortBy.Age },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return coloredItem(ageTextAndColor(issue, m.theme))
+		},
+	},
+	{
+		name: "updated", title: "updated", factor: 0.5, minWidth: 9, maxWidth: 9,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return coloredItem(ageText(issue.UpdatedAt), color.Focusable(m.theme.Muted, m.theme.Blurred))
+		},
+	},
+	{
+		name: "due", title: "due", factor: 0.5, minWidth: 9, maxWidth: 12,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			return coloredItem(dueTextAndColor(issue, m.theme))
+		},
+	},
+	{
+		name: "cycle", title: "cycle", factor: 0.5, minWidth: 10, maxWidth: 14,
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			cycle := issue.Cycle.Name
+			if cycle == "" && issue.Cycle.Number > 0 {
+				cycle = fmt.Sprintf("cycle %d", issue.Cycle.Number)
+			}
+			return coloredItem(cycle, color.Focusable(m.theme.Secondary, m.theme.Blurred))
+		},
+	},
+	{
+		name: "team", title: "team", factor: 1, maxWidth: 15,
+		sortBy:    func(k keymap.KeyMap) key.Binding { return k.SortBy.Team },
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Team },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			fg := color.Focusable(m.theme.Legible(issue.Team.Color), m.theme.Blurred)
+			return table.RowItem{
+				Normal:   m.highlight(issue.Team.Name, fg, terms),
+				Selected: m.highlight(issue.Team.Name, fg.Brighten(0.2), terms),
+			}
+		},
+	},
+	{
+		name: "labels", title: "labels", factor: 1, maxWidth: 40,
+		editField: func(k keymap.KeyMap) key.Binding { return k.EditField.Labels },
+		render: func(m *Model, issue store.Issue, terms []string) table.RowItem {
+			th := m.theme
+
+			var labelsNormal, labelsSelected []text.Focusable
+			for _, label := range issue.Labels {
+				labelFg := color.Focusable(th.Text, th.Blurred)
+				if text.Matches(label.Name, terms) {
+					labelFg = color.Focusable(th.Highlight, th.Header)
+				}
+
+				labelsNormal = append(labelsNormal, text.Chip(
+					label.Name,
+					labelFg,
+					color.Focusable(label.Color, th.Dim).Darken(0.5),
+				))
+				labelsSelected = append(labelsSelected, text.Chip(
+					label.Name,
+					labelFg.Brighten(0.2),
+					color.Focusable(label.Color, th.Dim).Darken(0.3),
+				))
+			}
+
+			return table.RowItem{
+				Normal:   text.Joined("", labelsNormal...),
+				Selected: text.Joined("", labelsSelected...),
+			}
+		},
+	},
+}
+
+var defaultColumns = []string{"project", "title", "pin", "assignee", "state", "prio", "age", "team", "labels"}
+
+func columnByName(name string) (column, bool) {
+	i := slices.IndexFunc(columns, func(c column) bool { return c.name == name })
+	if i == -1 {
+		return column{}, false
+	}
+	return columns[i], true
+}
+
+func (c column) shownIn(v view) bool {
+	return len(c.views) == 0 || slices.Contains(c.views, v)
+}
+
+// layoutName is what the layout of the view is stored under, the inbox has
+// none as its table isn't one of issues.
+func layoutName(v view) string {
+	switch v {
+	case ViewAll:
+		return "all"
+	case ViewProject:
+		return "project"
+	case ViewCustom:
+		return "custom"
+	case ViewArchive:
+		return "archive"
+	}
+	return ""
+}
+
+func defaultLayout(v view) []store.TableColumn {
+	names := slices.Clone(defaultColumns)
+	switch v {
+	case ViewProject:
+		names[0] = "milestone"
+	case ViewArchive:
+		names[0] = "removed"
+	}
+
+	layout := make([]store.TableColumn, 0, len(names))
+	for _, name := range names {
+		c, _ := columnByName(name)
+		layout = append(layout, store.TableColumn{Name: name, Width: float64(c.factor)})
+	}
+	return layout
+}
+
+// layout is the columns the issue table shows in the current view, the ones
+// being edited while the column editor is open.
+func (m *Model) layout() []store.TableColumn {
+	var layout []store.TableColumn
+
+	switch {
+	case m.columnDraft != nil:
+		for _, c := range m.columnDraft {
+			if c.shown {
+				layout = append(layout, c.TableColumn)
+			}
+		}
+	case len(m.layouts[m.currView]) > 0:
+		layout = m.layouts[m.currView]
+	default:
+		return defaultLayout(m.currView)
+	}
+
+	// NOTE: columns of older versions may be gone
+	return slices.DeleteFunc(slices.Clone(layout), func(tc store.TableColumn) bool {
+		_, ok := columnByName(tc.Name)
+		return !ok
+	})
+}
+
+// tableColumn is the column of the table laid out with width, the max width
+// grows and shrinks along with it.
+func (c column) tableColumn(title text.Focusable, width float64) *table.Column {
+	maxWidth := c.maxWidth
+	if !c.fixed() && maxWidth > 0 {
+		maxWidth = max(int(math.Round(float64(c.maxWidth)*width/float64(c.factor))), c.minWidth, 1)
+	}
+
+	switch {
+	case c.fill:
+		return table.NewColumn(title, float32(width), table.WithAutoFill())
+	case c.fixed():
+		return table.NewColumn(title, 0, table.WithMaxWidth(maxWidth), table.WithMinWidth(c.minWidth))
+	}
+	return table.NewColumn(title, float32(width), table.WithMaxWidth(maxWidth), table.WithMinWidth(c.minWidth))
+}
+
+func coloredItem(value string, fg color.Color) table.RowItem {
+	return table.RowItem{
+		Normal:   text.Colored(value, fg),
+		Selected: text.Colored(value, fg.Brighten(0.2)),
+	}
+}
+
+func (m *Model) userItem(user store.User, terms []string) table.RowItem {
+	fg := color.Focusable(m.theme.Blurred, m.theme.Blurred)
+	if user.IsMe {
+		fg = color.Focusable(m.theme.Positive, m.theme.Blurred)
+	}
+	return table.RowItem{
+		Normal:   m.highlight(user.DisplayName, fg, terms),
+		Selected: m.highlight(user.DisplayName, fg.Brighten(0.2), terms),
+	}
+}
+
+func (m *Model) renderPrio(p store.Prio, brighten float64) text.Focusable {
+	th := m.theme
+	switch p {
+	case 1:
+		return text.Colored("Urgent", color.Focusable(th.Priority.Urgent, th.Blurred).Brighten(brighten), text.B)
+	case 2:
+		return text.Colored("High", color.Focusable(th.Priority.High, th.Blurred).Brighten(brighten))
+	case 3:
+		return text.Colored("Medium", color.Focusable(th.Priority.Medium, th.Blurred).Brighten(brighten))
+	case 4:
+		return text.Colored("Low", color.Focusable(th.Priority.Low, th.Blurred).Brighten(brighten))
+	}
+	return text.Plain("")
+}
+
+// dueTextAndColor warns about issues due in the next days and the ones past
+// their due date that aren't done.
+func dueTextAndColor(issue store.Issue, th theme.Theme) (string, color.Color) {
+	if issue.DueDate == nil {
+		return "", color.Focusable(th.Secondary, th.Blurred)
+	}
+
+	due := *issue.DueDate
+	dueText := due.Format("Jan 2")
+	if due.Year() != time.Now().Year() {
+		dueText = due.Format("Jan 2 2006")
+	}
+
+	if issue.State.Type == "completed" || issue.State.Type == "canceled" {
+		return dueText, color.Focusable(th.Secondary, th.Blurred)
+	}
+
+	today := time.Now().Truncate(24 * time.Hour)
+	switch {
+	case due.Before(today):
+		return dueText, color.Focusable(th.Negative, th.Blurred)
+	case due.Before(today.Add(3 * 24 * time.Hour)):
+		return dueText, color.Focusable(th.Warning, th.Blurred)
+	}
+	return dueText, color.Focusable(th.Secondary, th.Blurred)
+}
+
+// draftColumn is a column in the column editor, hidden ones are listed after
+// the shown ones.
+type draftColumn struct {
+	store.TableColumn
+	shown bool
+}
+
+func newColumnDraft(v view, layout []store.TableColumn) []draftColumn {
+	draft := make([]draftColumn, 0, len(columns))
+	for _, tc := range layout {
+		draft = append(draft, draftColumn{TableColumn: tc, shown: true})
+	}
+
+	for _, c := range columns {
+		if !c.shownIn(v) || slices.ContainsFunc(layout, func(tc store.TableColumn) bool { return tc.Name == c.name }) {
+			continue
+		}
+		draft = append(draft, draftColumn{
+			TableColumn: store.TableColumn{Name: c.name, Width: float64(c.factor)},
+		})
+	}
+
+	return draft
+}
+
+// handleColumns opens the column editor of the issue table, the table shows
+// the layout as it's edited until it's saved or given up on with esc.
+func (m *Model) handleColumns(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		if !keymap.Matches(key, m.keys.Columns) || layoutName(m.currView) == "" {
+			return nil
+		}
+
+		onPop := func() tea.Msg {
+			m.columnDraft = nil
+			m.table.Focus()
+			m.updateTableCols()
+			m.updateTableRows(m.issues)
+			return nil
+		}
+
+		if m.focus.push(FocusColumns, onPop) {
+			m.columnDraft = newColumnDraft(m.currView, m.layout())
+			m.columnCursor = 0
+			m.table.Blur()
+		}
+
+	case FocusColumns:
+		ck := m.keys.Column
+		cursor := &m.columnDraft[m.columnCursor]
+
+		switch {
+		case keymap.Matches(key, ck.Up):
+			m.columnCursor = max(m.columnCursor-1, 0)
+			return nil
+		case keymap.Matches(key, ck.Down):
+			m.columnCursor = min(m.columnCursor+1, len(m.columnDraft)-1)
+			return nil
+		case keymap.Matches(key, ck.MoveUp):
+			if m.columnCursor == 0 {
+				return nil
+			}
+			m.columnDraft[m.columnCursor], m.columnDraft[m.columnCursor-1] = m.columnDraft[m.columnCursor-1], m.columnDraft[m.columnCursor]
+			m.columnCursor--
+		case keymap.Matches(key, ck.MoveDown):
+			if m.columnCursor == len(m.columnDraft)-1 {
+				return nil
+			}
+			m.columnDraft[m.columnCursor], m.columnDraft[m.columnCursor+1] = m.columnDraft[m.columnCursor+1], m.columnDraft[m.columnCursor]
+			m.columnCursor++
+		case keymap.Matches(key, ck.Toggle):
+			// NOTE: the title is where the selector and hints are placed
+			if cursor.Name == "title" {
+				return nil
+			}
+			cursor.shown = !cursor.shown
+		case keymap.Matches(key, ck.Wider):
+			cursor.Width = stepWidth(cursor.Name, cursor.Width, 0.1)
+		case keymap.Matches(key, ck.Narrower):
+			cursor.Width = stepWidth(cursor.Name, cursor.Width, -0.1)
+		case keymap.Matches(key, ck.Reset):
+			m.columnDraft = newColumnDraft(m.currView, defaultLayout(m.currView))
+			m.columnCursor = 0
+		case keymap.Matches(key, ck.Save):
+			return m.saveColumns()
+		default:
+			return nil
+		}
+
+		m.updateTableCols()
+		m.updateTableRows(m.issues)
+	}
+
+	return nil
+}
+
+// stepWidth changes the width of a column by a tenth, fixed ones stay as
+// they are.
+func stepWidth(name string, width, step float64) float64 {
+	c, ok := columnByName(name)
+	if !ok || c.fixed() {
+		return width
+	}
+	return min(max(math.Round((width+step)*10)/10, 0.1), 1)
+}
+
+func (m *Model) saveColumns() tea.Cmd {
+	var layout []store.TableColumn
+	for _, c := range m.columnDraft {
+		if c.shown {
+			layout = append(layout, c.TableColumn)
+		}
+	}
+
+	// NOTE: the default layout isn't stored so it follows later defaults
+	if slices.Equal(layout, defaultLayout(m.currView)) {
+		layout = nil
+	}
+
+	err := m.store.SetTableColumns(layoutName(m.currView), layout)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if m.layouts == nil {
+		m.layouts = make(map[view][]store.TableColumn)
+	}
+	m.layouts[m.currView] = layout
+
+	return m.focus.pop()
+}
+
+// columnEditor lists the columns of the view while they're laid out, with
+// the keys laying them out below.
+func (m *Model) columnEditor() string {
+	if m.focus.current() != FocusColumns {
+		return ""
+	}
+
+	th := m.theme
+	cursorStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Accent)).Bold(true)
+	shownStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Text))
+	hiddenStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Faint))
+	widthStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Secondary))
+	keyStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Accent)).Bold(true)
+	descStyle := lipgloss.NewStyle().Foreground(color.Terminal(th.Muted))
+
+	nameWidth := 0
+	for _, c := range m.columnDraft {
+		nameWidth = max(nameWidth, lipgloss.Width(c.Name))
+	}
+
+	var lines []string
+	for i, c := range m.columnDraft {
+		pointer := "  "
+		if i == m.columnCursor {
+			pointer = cursorStyle.Render("> ")
+		}
+
+		check, style := "[ ] ", hiddenStyle
+		if c.shown {
+			check, style = "[x] ", shownStyle
+		}
+
+		var width string
+		if col, _ := columnByName(c.Name); !col.fixed() {
+			filled := int(math.Round(c.Width * 10))
+			width = widthStyle.Render(strings.Repeat("▮", filled) + strings.Repeat("▯", 10-filled))
+		}
+
+		lines = append(lines, pointer+style.Render(check+fmt.Sprintf("%-*s", nameWidth+1, c.Name))+width)
+	}
+
+	bindings := slices.DeleteFunc(m.keys.Column.ShortHelp(), func(b key.Binding) bool {
+		return !b.Enabled()
+	})
+
+	lines = append(lines, "")
+	var hints []string
+	for i, b := range bindings {
+		hints = append(hints, keyStyle.Render(b.Help().Key)+" "+descStyle.Render(b.Help().Desc))
+		if len(hints) == 3 || i == len(bindings)-1 {
+			lines = append(lines, strings.Join(hints, "  "))
+			hints = nil
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+
+	return box.New(
+		"columns",
+		content,
+		lipgloss.Width(content)+2,
+		box.WithBorderStyle(lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(color.Terminal(th.Border)).
+			Padding(0, 1)),
+		box.WithLabelStyle(lipgloss.NewStyle().Foreground(color.Terminal(th.Muted)).Padding(0, 1)),
+	)
+}
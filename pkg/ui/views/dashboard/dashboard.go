@@ -6,9 +6,14 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/git"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/input"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 )
 
 const (
@@ -20,11 +25,21 @@ const (
 	FocusHover
 	FocusSelectorPre
 	FocusSelector
+	FocusInbox
+	FocusCustomViews
+	FocusColumns
+	FocusGroup
+	FocusBoard
+	FocusDetail
 )
 
 const (
 	ViewAll view = iota
 	ViewProject
+	ViewCustom
+	ViewInbox
+	ViewArchive
+	ViewBoard
 )
 
 const (
@@ -36,11 +51,22 @@ const (
 	SelectorModeTeam
 	SelectorModeTitle
 	SelectorModeLabels
+	SelectorModeSnooze
+	SelectorModeMilestone
+	SelectorModeWorkspace
+	SelectorModeConfirm
+	SelectorModeExport
+	SelectorModeSaveFilter
+	SelectorModeSavedFilters
+	SelectorModeGoTo
 )
 
 var focusNextMap = map[focus][]focus{
-	FocusProjects: {FocusIssues},
-	FocusIssues:   {FocusVisual, FocusSort, FocusFilter, FocusHover, FocusSelector, FocusSelectorPre},
+	FocusProjects:    {FocusIssues, FocusHover, FocusSelector},
+	FocusCustomViews: {FocusIssues, FocusSelector},
+	FocusIssues:      {FocusVisual, FocusSort, FocusFilter, FocusHover, FocusSelector, FocusSelectorPre, FocusColumns, FocusGroup, FocusDetail},
+	FocusInbox:       {FocusSelector},
+	FocusBoard:       {FocusHover},
 }
 
 type (
@@ -62,62 +88,112 @@ type (
 		currView  view
 		switching bool
 
-		hovered *store.Issue
+		hovered        *store.Issue
+		hoveredProject *store.Project
 
 		store  *store.Store
 		client *client.Client
+		config *config.Config
+		keys   keymap.KeyMap
+		theme  theme.Theme
 
-		prjTable table.Model
-		table    table.Model
-		input    textinput.Model
+		prjTable   table.Model
+		viewsTable table.Model
+		table      table.Model
+		inboxTable table.Model
+		input      textinput.Model
+		filterErr  *store.QueryError
+
+		layouts      map[view][]store.TableColumn
+		columnDraft  []draftColumn
+		columnCursor int
+
+		collapsed map[string]bool
+
+		board  board
+		detail detailPane
+
+		remoteSearch    string
+		searchingRemote bool
+
+		issues    []store.Issue
+		unread    int
+		workingOn string
 
 		selector     input.Model
 		selectorMode selectorMode
+		confirm      func() tea.Cmd
 
 		err   error
 		debug string
 	}
 )
 
-func New(store *store.Store, client *client.Client) *Model {
+func New(store *store.Store, client *client.Client, cfg *config.Config, keys keymap.KeyMap, th theme.Theme) *Model {
 	var model Model
 
 	st := table.DefaultStyles()
 
 	st.Selected = st.Selected.
+		Background(color.Terminal(th.Selection)).
 		Border(lipgloss.NormalBorder(), false).
-		BorderForeground(lipgloss.Color("#2D4F67")).
+		BorderForeground(color.Terminal(th.SelectionBorder)).
 		UnsetPadding().
 		BorderLeft(true)
 
 	st.Header = st.Header.
 		Border(lipgloss.ThickBorder(), false).
-		BorderForeground(lipgloss.Color("#3d3223")).
+		BorderForeground(color.Terminal(th.HeaderBorder)).
 		BorderBottom(true)
 
+	st.SelectedBlurred = st.SelectedBlurred.
+		Background(color.Terminal(th.SelectionBlurred))
+
+	// the cursor is only a background otherwise
+	if color.NoColor() {
+		st.Selected = st.Selected.Reverse(true)
+		st.SelectedBlurred = st.SelectedBlurred.Underline(true)
+	}
+
 	model.table = table.New(
 		table.WithFocused(true),
 		table.WithSpinner(spinner.Dot),
 		table.WithLoadingText("loading..."),
 		table.WithVisualMode(true),
 		table.WithStyles(st),
+		table.WithKeyMap(keys.Table),
 	)
 	model.prjTable = table.New(
 		table.WithFocused(false),
 		table.WithStyles(st),
+		table.WithKeyMap(keys.Table),
+	)
+	model.viewsTable = table.New(
+		table.WithFocused(false),
+		table.WithStyles(st),
+		table.WithKeyMap(keys.Table),
+	)
+	model.inboxTable = table.New(
+		table.WithFocused(false),
+		table.WithVisualMode(true),
+		table.WithStyles(st),
+		table.WithKeyMap(keys.Table),
 	)
 
 	model.client = client
+	model.config = cfg
+	model.keys = keys
+	model.theme = th
 	model.store = store
 	model.syncing = true
 
 	model.input = textinput.New()
 	model.input.Prompt = ""
-	model.input.TextStyle = model.input.TextStyle.Foreground(lipgloss.Color("#999"))
+	model.input.TextStyle = model.input.TextStyle.Foreground(color.Terminal(th.Secondary))
 
 	model.focus = []focusStackItem{{mode: FocusIssues}}
 
-	model.selector = input.New(12, true)
+	model.selector = input.New(12, true, th)
 
 	return &model
 }
@@ -125,11 +201,21 @@ func New(store *store.Store, client *client.Client) *Model {
 func (m *Model) Init() tea.Cmd {
 	m.updateTableCols()
 
+	var opts []updateTablesOptFunc
+
+	// start on the issue the checked out branch belongs to
+	if branch, err := git.CurrentBranch(); err == nil && branch != "" {
+		if issueID, err := m.store.IssueIDByBranch(branch); err == nil && issueID != "" {
+			opts = append(opts, withSelectedIssue(issueID))
+		}
+	}
+
 	return tea.Batch(
 		m.selector.Init(),
-		m.updateTables(),
+		m.updateTables(opts...),
 		m.table.SetLoading(m.store.Current().FirstTime),
 		m.client.GetMe(),
+		m.scanCommits(),
 	)
 }
 
@@ -0,0 +1,281 @@
+package dashboard
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/atoms/box"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/atoms/hover"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+)
+
+// activityDebounce is how long the cursor has to stay on an issue before its
+// activity is fetched, so scrolling through the table doesn't fetch them all.
+const activityDebounce = 300 * time.Millisecond
+
+type fetchActivityMsg string
+
+// fetchedActivityMsg is the answer to fetching the activity of an issue, it
+// comes back even if the fetch failed so the pane stops waiting on it.
+type fetchedActivityMsg struct {
+	issueID  string
+	activity *client.IssueActivity
+	err      error
+}
+
+// detailPane follows the cursor of the issue table, showing everything about
+// the issue under it next to the table.
+type detailPane struct {
+	open   bool
+	issue  *store.Issue
+	scroll int
+	// activity of issues by their id, along with the updated at of the issue
+	// it was fetched for
+	activity map[string]fetchedActivity
+	// issue the activity is being fetched of
+	pending string
+	// what the pane scrolls through, rendered once per update
+	lines []string
+}
+
+type fetchedActivity struct {
+	hover.IssueActivity
+	revision time.Time
+}
+
+// detailShown reports whether the pane is open in a view with an issue table.
+func (m *Model) detailShown() bool {
+	return m.detail.open && m.currView != ViewInbox && m.currView != ViewBoard
+}
+
+func (m *Model) detailWidth(width int) int {
+	if !m.detailShown() {
+		return 0
+	}
+	return min(max(width*2/5, 40), 90, width/2)
+}
+
+// resizeTables fits the tables of the view in width, next to the detail pane
+// if it's shown.
+func (m *Model) resizeTables(width int) {
+	issuesWidth := width - m.detailWidth(width)
+
+	switch m.currView {
+	case ViewAll, ViewArchive:
+		m.table.SetWidth(issuesWidth)
+	case ViewProject:
+		m.prjTable.SetWidth(projectsTableWidth)
+		m.table.SetWidth(issuesWidth - projectsTableWidth)
+	case ViewCustom:
+		m.viewsTable.SetWidth(projectsTableWidth)
+		m.table.SetWidth(issuesWidth - projectsTableWidth)
+	case ViewInbox:
+		m.inboxTable.SetWidth(width)
+	}
+}
+
+func (m *Model) handleDetail(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		switch {
+		case keymap.Matches(key, m.keys.Detail):
+			m.detail.open = !m.detail.open
+			m.resizeTables(m.width)
+
+		case keymap.Matches(key, m.keys.FocusDetail):
+			onPop := func() tea.Msg {
+				m.table.Focus()
+				return nil
+			}
+
+			if m.focus.push(FocusDetail, onPop) {
+				m.detail.open = true
+				m.resizeTables(m.width)
+				m.table.Blur()
+			}
+		}
+
+	case FocusDetail:
+		page := max(m.detailHeight()/2, 1)
+
+		switch {
+		case keymap.Matches(key, m.keys.Detail):
+			m.detail.open = false
+			m.resizeTables(m.width)
+			return m.focus.pop()
+		case keymap.Matches(key, m.keys.FocusDetail):
+			return m.focus.pop()
+		case keymap.Matches(key, m.keys.DetailScroll.Up):
+			m.detail.scroll--
+		case keymap.Matches(key, m.keys.DetailScroll.Down):
+			m.detail.scroll++
+		case keymap.Matches(key, m.keys.DetailScroll.HalfPageUp):
+			m.detail.scroll -= page
+		case keymap.Matches(key, m.keys.DetailScroll.HalfPageDown):
+			m.detail.scroll += page
+		case keymap.Matches(key, m.keys.DetailScroll.Top):
+			m.detail.scroll = 0
+		case keymap.Matches(key, m.keys.DetailScroll.Bottom):
+			m.detail.scroll = len(m.detail.lines)
+		}
+
+		m.detail.scroll = max(min(m.detail.scroll, len(m.detail.lines)-m.detailHeight()), 0)
+	}
+
+	return nil
+}
+
+// syncDetail moves the pane to the issue under the cursor, fetching its
+// activity once the cursor stays on it unless it didn't change since the
+// last time.
+func (m *Model) syncDetail() tea.Cmd {
+	if !m.detailShown() {
+		return nil
+	}
+
+	selected := m.table.SelectedRow()
+	if selected == "" {
+		m.detail.issue = nil
+		return nil
+	}
+
+	issue, err := m.store.Issue(selected)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if m.detail.issue == nil || m.detail.issue.ID != issue.ID {
+		m.detail.scroll = 0
+	}
+	m.detail.issue = issue
+
+	fetched, ok := m.detail.activity[issue.ID]
+	if (ok && fetched.revision.Equal(issue.UpdatedAt)) || m.detail.pending == issue.ID {
+		return nil
+	}
+	m.detail.pending = issue.ID
+
+	return tea.Tick(activityDebounce, func(time.Time) tea.Msg {
+		return fetchActivityMsg(issue.ID)
+	})
+}
+
+func (m *Model) fetchActivity(issueID string) tea.Cmd {
+	if m.detail.pending != issueID {
+		return nil
+	}
+	if !m.detailShown() || m.detail.issue == nil || m.detail.issue.ID != issueID {
+		m.detail.pending = ""
+		return nil
+	}
+
+	fetch := m.client.GetIssueActivity(issueID)
+
+	return func() tea.Msg {
+		switch msg := fetch().(type) {
+		case client.GetIssueActivityRes:
+			return fetchedActivityMsg{issueID: issueID, activity: &msg.Result}
+		case error:
+			return fetchedActivityMsg{issueID: issueID, err: msg}
+		}
+		// NOTE: the client was closed before linear answered
+		return fetchedActivityMsg{issueID: issueID}
+	}
+}
+
+func (m *Model) fetchedActivity(msg fetchedActivityMsg) tea.Cmd {
+	if m.detail.pending == msg.issueID {
+		m.detail.pending = ""
+	}
+
+	if msg.err != nil {
+		return returnError(fmt.Errorf("couldn't fetch activity: %w", msg.err))
+	}
+	if msg.activity == nil {
+		return nil
+	}
+	activity := *msg.activity
+
+	var revision time.Time
+	if issue, err := m.store.Issue(activity.IssueID); err == nil {
+		revision = issue.UpdatedAt
+	}
+
+	if m.detail.activity == nil {
+		m.detail.activity = make(map[string]fetchedActivity)
+	}
+	m.detail.activity[activity.IssueID] = fetchedActivity{
+		IssueActivity: hover.IssueActivity{
+			Comments: activity.Comments,
+			History:  activity.History,
+		},
+		revision: revision,
+	}
+
+	return nil
+}
+
+func (m *Model) detailHeight() int {
+	// the border
+	return m.height - 6
+}
+
+// detailLines renders what the pane scrolls through, line by line.
+func (m *Model) detailLines() []string {
+	if !m.detailShown() || m.detail.issue == nil {
+		return nil
+	}
+
+	var activity *hover.IssueActivity
+	if fetched, ok := m.detail.activity[m.detail.issue.ID]; ok {
+		activity = &fetched.IssueActivity
+	}
+
+	width := m.detailWidth(m.width) - 4
+	content := hover.IssueDetail(*m.detail.issue, activity, m.theme, width, m.focus.current() == FocusDetail)
+
+	return strings.Split(content, "\n")
+}
+
+func (m *Model) renderDetail() string {
+	th := m.theme
+	width := m.detailWidth(m.width)
+	height := m.detailHeight()
+
+	lines := m.detail.lines
+	scroll := max(min(m.detail.scroll, len(lines)-height), 0)
+	lines = lines[scroll:min(scroll+height, len(lines))]
+
+	label := "detail"
+	if m.detail.issue != nil {
+		label = m.detail.issue.Identifier
+	}
+
+	borderColor := th.Border
+	if m.focus.current() == FocusDetail {
+		borderColor = th.SelectionBorder
+	}
+
+	content := lipgloss.NewStyle().
+		Width(width - 4).
+		Height(height).
+		MaxHeight(height).
+		Render(strings.Join(lines, "\n"))
+
+	return box.New(
+		label,
+		content,
+		width-2,
+		box.WithBorderStyle(lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(color.Terminal(borderColor)).
+			Padding(0, 1)),
+		box.WithLabelStyle(lipgloss.NewStyle().Foreground(color.Terminal(th.Muted)).Padding(0, 1)),
+	)
+}
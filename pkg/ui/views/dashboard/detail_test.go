@@ -0,0 +1,72 @@
+package dashboard
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type unreachable struct{}
+
+func (unreachable) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("linear is unreachable")
+}
+
+// messages runs cmd, along with the commands it batches.
+func messages(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	msg := cmd()
+
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+
+	var msgs []tea.Msg
+	for _, cmd := range batch {
+		msgs = append(msgs, messages(cmd)...)
+	}
+	return msgs
+}
+
+func TestDetailActivityFailed(t *testing.T) {
+	transport := http.DefaultTransport
+	http.DefaultTransport = unreachable{}
+	t.Cleanup(func() { http.DefaultTransport = transport })
+
+	m := newTestModel(t, 2)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+
+	issueID := m.detail.pending
+	if issueID == "" || m.detail.issue == nil || m.detail.issue.ID != issueID {
+		t.Fatalf("expected the activity of the selected issue to be pending, got %q", issueID)
+	}
+	if len(m.detail.lines) == 0 {
+		t.Fatal("expected the pane to be rendered")
+	}
+
+	// NOTE: the debounce is skipped, the tick would send the same message
+	_, fetch := m.Update(fetchActivityMsg(issueID))
+	for _, msg := range messages(fetch) {
+		_, failed := m.Update(msg)
+		for _, msg := range messages(failed) {
+			m.Update(msg)
+		}
+	}
+
+	if m.detail.pending != "" {
+		t.Fatalf("expected the pane to stop waiting on %q", m.detail.pending)
+	}
+	if m.err == nil {
+		t.Fatal("expected the error to be shown")
+	}
+	if _, ok := m.detail.activity[issueID]; ok {
+		t.Fatal("expected no activity to be kept for the failed fetch")
+	}
+}
@@ -0,0 +1,94 @@
+package dashboard
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aymanbagabas/go-osc52/v2"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/export"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/input"
+)
+
+// handleExport copies the issues of the current view to the clipboard in the
+// chosen format, with the columns the table shows.
+func (m *Model) handleExport(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		if !keymap.Matches(key, m.keys.Export) {
+			return nil
+		}
+
+		onPop := func() tea.Msg {
+			m.table.Focus()
+			m.selector.Reset()
+			return nil
+		}
+
+		if m.focus.push(FocusSelector, onPop) {
+			var suggestions []input.Suggestion
+			for _, format := range export.Formats {
+				suggestions = append(suggestions, input.Suggestion{
+					Identifier: string(format),
+					Title:      string(format),
+				})
+			}
+			m.table.Blur()
+			m.selector.SetSuggestions(suggestions)
+			m.selectorMode = SelectorModeExport
+		}
+
+	case FocusSelector:
+		if m.selectorMode != SelectorModeExport {
+			return nil
+		}
+
+		var cmd tea.Cmd
+		m.selector, cmd = m.selector.Update(key)
+
+		if key.Type != tea.KeyEnter {
+			return cmd
+		}
+
+		suggested := m.selector.Highlighted()
+		if suggested == nil {
+			return nil
+		}
+
+		var b strings.Builder
+		err := export.Write(&b, export.Format(suggested.Identifier), m.table.ColumnTitles(), m.issues)
+		if err != nil {
+			return tea.Batch(m.focus.pop(), returnError(err))
+		}
+
+		err = copyToClipboard(b.String())
+		if err != nil {
+			return tea.Batch(m.focus.pop(), returnError(err))
+		}
+
+		return m.focus.pop()
+	}
+
+	return nil
+}
+
+func copyToClipboard(s string) error {
+	seq := osc52.New(s)
+
+	// NOTE: multiplexers swallow the sequence unless it's wrapped for them
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case strings.HasPrefix(os.Getenv("TERM"), "screen"):
+		seq = seq.Screen()
+	}
+
+	_, err := seq.WriteTo(os.Stderr)
+	if err != nil {
+		return fmt.Errorf("couldn't copy to clipboard: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package dashboard
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+)
+
+var (
+	matchIssueURL        = regexp.MustCompile(`/issue/([A-Za-z][A-Za-z0-9]*-\d+)`)
+	matchIssueIdentifier = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*-\d+$`)
+	matchIssueNumber     = regexp.MustCompile(`^#?\d+$`)
+)
+
+type goToMsg string
+
+// handleGoTo jumps to an issue with ctrl+g, by identifier, number or link.
+func (m *Model) handleGoTo(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		if !keymap.Matches(key, m.keys.GoTo) {
+			return nil
+		}
+
+		if m.focus.push(FocusSelector, m.goToPopped) {
+			m.table.Blur()
+			m.selector.SetSuggestions(nil)
+			m.selectorMode = SelectorModeGoTo
+		}
+
+	case FocusSelector:
+		if m.selectorMode != SelectorModeGoTo {
+			return nil
+		}
+
+		var cmd tea.Cmd
+		m.selector, cmd = m.selector.Update(key)
+
+		if key.Type != tea.KeyEnter {
+			return cmd
+		}
+
+		identifier, err := m.issueIdentifier(m.selector.Value())
+		if err != nil {
+			return tea.Batch(m.focus.pop(), returnError(err))
+		}
+
+		// NOTE: like saved filters, views are switched once the key is handled
+		return tea.Batch(m.focus.pop(), func() tea.Msg {
+			return goToMsg(identifier)
+		})
+	}
+
+	return nil
+}
+
+func (m *Model) goToPopped() tea.Msg {
+	m.table.Focus()
+	m.selector.Reset()
+	return nil
+}
+
+// issueIdentifier reads what's typed in the go to prompt, bare numbers are
+// of the team of the issue under the cursor.
+func (m *Model) issueIdentifier(value string) (string, error) {
+	value = strings.TrimSpace(value)
+
+	if match := matchIssueURL.FindStringSubmatch(value); match != nil {
+		return strings.ToUpper(match[1]), nil
+	}
+
+	if matchIssueIdentifier.MatchString(value) {
+		return strings.ToUpper(value), nil
+	}
+
+	if !matchIssueNumber.MatchString(value) {
+		return "", fmt.Errorf("%q isn't an issue identifier, number or link", value)
+	}
+
+	issue, err := m.store.Issue(m.table.SelectedRow())
+	if err != nil {
+		return "", fmt.Errorf("couldn't find the team of issue %s: %w", value, err)
+	}
+
+	key, _, _ := strings.Cut(issue.Identifier, "-")
+
+	return fmt.Sprintf("%s-%s", key, strings.TrimPrefix(value, "#")), nil
+}
+
+// goTo lands on the issue, it's fetched when it isn't stored yet.
+func (m *Model) goTo(identifier string) tea.Cmd {
+	issueID, err := m.store.IssueIDByIdentifier(identifier)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if issueID == "" {
+		return m.client.GetIssue(identifier)
+	}
+
+	return m.landOn(issueID)
+}
+
+// landOn selects the issue in the table, switching the view, project or
+// filter when they hide it. Issues no view shows are hovered instead.
+func (m *Model) landOn(issueID string) tea.Cmd {
+	if slices.ContainsFunc(m.issues, func(issue store.Issue) bool { return issue.ID == issueID }) {
+		m.table.SetSelectedRow(issueID)
+		return nil
+	}
+
+	issue, err := m.store.Issue(issueID)
+	if err != nil {
+		return returnError(err)
+	}
+
+	if m.input.Value() != "" {
+		m.input.SetValue("")
+		m.applyFilter()
+	}
+
+	var cmd tea.Cmd
+	switch {
+	case issue.ArchivedAt != nil || issue.Trashed:
+		cmd = m.setView(ViewArchive, withSelectedIssue(issueID))
+	case m.currView == ViewProject && issue.Project.ID != "":
+		m.store.SetProject(&issue.Project)
+		cmd = m.updateTables(withSelectedProject(issue.Project.ID), withSelectedIssue(issueID))
+	default:
+		cmd = m.setView(ViewAll, withSelectedIssue(issueID))
+	}
+
+	// NOTE: with the filter cleared, what the store returns is what the table shows
+	visible, err := m.store.Issues(issueID)
+	if err != nil {
+		return returnError(err)
+	}
+	if len(visible) > 0 {
+		return cmd
+	}
+
+	// NOTE: done issues leave the table after a while, old ones never made it
+	return tea.Batch(cmd, m.hoverIssue(issue))
+}
+
+func (m *Model) fetchedIssue(issue store.Issue) tea.Cmd {
+	err := m.store.StoreRemoteIssues([]store.Issue{issue})
+	if err != nil {
+		return returnError(err)
+	}
+
+	return m.landOn(issue.ID)
+}
@@ -0,0 +1,174 @@
+package dashboard
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+)
+
+const groupRowPrefix = "group:"
+
+func (m *Model) grouped() bool {
+	return m.store.Current().Org.GroupMode != store.GroupModeNone && m.currView != ViewInbox
+}
+
+func (m *Model) handleGroup(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		if !keymap.Matches(key, m.keys.Group) {
+			return nil
+		}
+		onPop := tea.Batch(
+			func() tea.Msg {
+				m.table.Focus()
+				return nil
+			},
+			m.updateTables(),
+		)
+
+		if m.focus.push(FocusGroup, onPop) {
+			m.table.Blur()
+		}
+
+	case FocusGroup:
+		var groupMode store.GroupMode
+		switch {
+		case keymap.Matches(key, m.keys.GroupBy.State):
+			groupMode = store.GroupModeState
+		case keymap.Matches(key, m.keys.GroupBy.Assignee):
+			groupMode = store.GroupModeAssignee
+		case keymap.Matches(key, m.keys.GroupBy.Project):
+			groupMode = store.GroupModeProject
+		case keymap.Matches(key, m.keys.GroupBy.Prio):
+			groupMode = store.GroupModePrio
+		case keymap.Matches(key, m.keys.GroupBy.Team):
+			groupMode = store.GroupModeTeam
+		case keymap.Matches(key, m.keys.GroupBy.Label):
+			groupMode = store.GroupModeLabel
+		case keymap.Matches(key, m.keys.GroupBy.None):
+			groupMode = store.GroupModeNone
+		default:
+			return nil
+		}
+
+		err := m.store.SetGroupMode(groupMode)
+		if err != nil {
+			return returnError(err)
+		}
+		m.collapsed = nil
+
+		return m.focus.pop()
+	}
+	return nil
+}
+
+// handleCollapse folds the group of the issue under the cursor into its
+// header, or all groups but that one. Folded headers take the cursor so they
+// can be unfolded again.
+func (m *Model) handleCollapse(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusIssues || !m.grouped() {
+		return nil
+	}
+
+	selected := m.table.SelectedRow()
+	header := m.table.SelectedHeader()
+	if selected == "" && header == "" {
+		return nil
+	}
+
+	current := strings.TrimPrefix(header, groupRowPrefix)
+	groups := make(map[string]bool)
+	for _, issue := range m.issues {
+		groups[issue.Group.Key] = true
+		if issue.ID == selected {
+			current = issue.Group.Key
+		}
+	}
+
+	switch {
+	case keymap.Matches(key, m.keys.Collapse):
+		if header != "" {
+			// NOTE: the cursor settles on the first issue of the unfolded group
+			delete(m.collapsed, current)
+			break
+		}
+		if m.collapsed == nil {
+			m.collapsed = make(map[string]bool)
+		}
+		m.collapsed[current] = true
+		selected = groupRowPrefix + current
+
+	case keymap.Matches(key, m.keys.CollapseAll):
+		if len(m.collapsed) > 0 {
+			m.collapsed = nil
+			break
+		}
+		m.collapsed = make(map[string]bool)
+		for group := range groups {
+			if group != current {
+				m.collapsed[group] = true
+			}
+		}
+
+	default:
+		return nil
+	}
+
+	m.updateTableRows(m.issues)
+	m.table.SetSelectedRow(selected)
+
+	return nil
+}
+
+// groupHeader is the row over the group starting at issues[start], with how
+// many issues it has and what they're estimated at.
+func (m *Model) groupHeader(issues []store.Issue, start int) *table.Row {
+	th := m.theme
+	group := issues[start].Group
+
+	var count int
+	var estimate float64
+	for _, issue := range issues[start:] {
+		if issue.Group.Key != group.Key {
+			break
+		}
+		count++
+		if issue.Estimate != nil {
+			estimate += *issue.Estimate
+		}
+	}
+
+	arrow := "▾ "
+	if m.collapsed[group.Key] {
+		arrow = "▸ "
+	}
+
+	fg := color.Focusable(th.Header, th.Blurred)
+	if group.Color != "" {
+		fg = color.Focusable(th.Legible(group.Color), th.Blurred)
+	}
+
+	summary := fmt.Sprintf("  %d", count)
+	if estimate > 0 {
+		summary += fmt.Sprintf(" · %s pts", strconv.FormatFloat(estimate, 'f', -1, 64))
+	}
+
+	header := text.Joined("",
+		text.Colored(arrow+group.Name, fg, text.B),
+		text.Colored(summary, color.Focusable(th.Muted, th.Faint)),
+	)
+
+	return &table.Row{
+		Identifier: groupRowPrefix + group.Key,
+		Items:      []table.RowItem{{Normal: header, Selected: header}},
+		Header:     true,
+		Selectable: m.collapsed[group.Key],
+	}
+}
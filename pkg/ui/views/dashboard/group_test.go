@@ -0,0 +1,62 @@
+package dashboard
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+)
+
+func groupedTestModel(t *testing.T, issues int) *Model {
+	t.Helper()
+
+	m := newTestModel(t, issues)
+
+	err := m.store.SetGroupMode(store.GroupModeAssignee)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Update(m.updateTables()())
+
+	return m
+}
+
+func TestCollapseToggle(t *testing.T) {
+	// none of the issues are assigned, they're all in the group keyed ''
+	m := groupedTestModel(t, 3)
+
+	z := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")}
+
+	if m.table.SelectedRow() == "" {
+		t.Fatal("expected the cursor on an issue")
+	}
+
+	m.Update(z)
+
+	if !m.collapsed[""] {
+		t.Fatalf("expected the group to be collapsed, got %v", m.collapsed)
+	}
+	if header := m.table.SelectedHeader(); header != groupRowPrefix {
+		t.Fatalf("expected the cursor on the collapsed header, got %q", header)
+	}
+
+	m.Update(z)
+
+	if m.collapsed[""] {
+		t.Fatalf("expected the group to be expanded, got %v", m.collapsed)
+	}
+	if m.table.SelectedRow() == "" {
+		t.Fatal("expected the cursor back on an issue")
+	}
+}
+
+func TestCollapseNothingSelected(t *testing.T) {
+	m := groupedTestModel(t, 0)
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+
+	if len(m.collapsed) > 0 {
+		t.Fatalf("expected no group to be collapsed, got %v", m.collapsed)
+	}
+}
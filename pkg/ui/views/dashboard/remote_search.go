@@ -0,0 +1,95 @@
+package dashboard
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+)
+
+type remoteSearchMsg string
+
+func (m *Model) searchText() string {
+	if query := m.store.Current().Query; query != nil {
+		return query.Text
+	}
+	return ""
+}
+
+// handleRemoteSearch searches linear with ctrl+r, for issues older than the
+// synced ones.
+func (m *Model) handleRemoteSearch(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues, FocusFilter:
+	default:
+		return nil
+	}
+
+	if !keymap.Matches(key, m.keys.SearchRemote) {
+		return nil
+	}
+
+	search := m.searchText()
+	if search == "" {
+		return nil
+	}
+
+	m.remoteSearch = search
+
+	return func() tea.Msg {
+		return remoteSearchMsg(search)
+	}
+}
+
+// searchRemoteIfEmpty searches linear once when nothing synced matches the
+// search, after a while the search stayed the same.
+func (m *Model) searchRemoteIfEmpty(issues []store.Issue) tea.Cmd {
+	search := m.searchText()
+	if search == "" || len(issues) > 0 || m.filterErr != nil || search == m.remoteSearch {
+		return nil
+	}
+
+	m.remoteSearch = search
+
+	return tea.Tick(400*time.Millisecond, func(time.Time) tea.Msg {
+		return remoteSearchMsg(search)
+	})
+}
+
+func (m *Model) searchRemote(search string) tea.Cmd {
+	if search != m.searchText() {
+		return nil
+	}
+
+	teams, err := m.store.Teams()
+	if err != nil {
+		return returnError(err)
+	}
+
+	var teamIDs []string
+	for _, team := range teams {
+		teamIDs = append(teamIDs, team.ID)
+	}
+
+	m.searchingRemote = true
+
+	return m.client.SearchIssues(search, teamIDs)
+}
+
+func (m *Model) remoteSearched(res client.SearchIssuesRes) tea.Cmd {
+	m.searchingRemote = false
+
+	// NOTE: the search changed while linear was searched, the results are stale
+	if res.Search != m.searchText() {
+		return nil
+	}
+
+	err := m.store.StoreRemoteIssues(res.Issues)
+	if err != nil {
+		return returnError(err)
+	}
+
+	return m.updateTables()
+}
@@ -0,0 +1,191 @@
+package dashboard
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/input"
+)
+
+type savedFilterMsg store.SavedFilter
+
+// handleSavedFilters saves the filter, sort and project in use under a name,
+// picks a saved one from a list or directly by its number.
+func (m *Model) handleSavedFilters(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues:
+		switch {
+		case keymap.Matches(key, m.keys.SaveFilter):
+			if m.filterErr != nil {
+				return returnError(fmt.Errorf("couldn't save filter: %w", m.filterErr))
+			}
+
+			if m.focus.push(FocusSelector, m.savedFiltersPopped) {
+				m.table.Blur()
+				m.selector.SetSuggestions(nil)
+				m.selectorMode = SelectorModeSaveFilter
+			}
+
+		case keymap.Matches(key, m.keys.SavedFilters):
+			filters, err := m.store.SavedFilters()
+			if err != nil {
+				return returnError(err)
+			}
+
+			if m.focus.push(FocusSelector, m.savedFiltersPopped) {
+				m.table.Blur()
+				m.selector.SetSuggestions(savedFilterSuggestions(filters))
+				m.selectorMode = SelectorModeSavedFilters
+			}
+
+		case keymap.Matches(key, m.keys.PickSavedFilter):
+			n := slices.Index(m.keys.PickSavedFilter.Keys(), key.String()) + 1
+
+			filters, err := m.store.SavedFilters()
+			if err != nil {
+				return returnError(err)
+			}
+			if n > len(filters) {
+				return nil
+			}
+
+			return pickSavedFilter(filters[n-1])
+		}
+
+	case FocusSelector:
+		switch m.selectorMode {
+		case SelectorModeSaveFilter:
+			var cmd tea.Cmd
+			m.selector, cmd = m.selector.Update(key)
+
+			if key.Type != tea.KeyEnter {
+				return cmd
+			}
+
+			name := m.selector.Value()
+			if name == "" {
+				return nil
+			}
+
+			err := m.store.SaveFilter(name, strings.TrimPrefix(m.input.Value(), "/"))
+			if err != nil {
+				return tea.Batch(m.focus.pop(), returnError(err))
+			}
+
+			return m.focus.pop()
+
+		case SelectorModeSavedFilters:
+			// NOTE: ctrl+d would otherwise delete a character of the input
+			if keymap.Matches(key, m.keys.DeleteSavedFilter) {
+				suggested := m.selector.Highlighted()
+				if suggested == nil {
+					return nil
+				}
+
+				err := m.store.DeleteSavedFilter(suggested.Identifier)
+				if err != nil {
+					return returnError(err)
+				}
+
+				filters, err := m.store.SavedFilters()
+				if err != nil {
+					return returnError(err)
+				}
+
+				m.selector.SetSuggestions(savedFilterSuggestions(filters))
+				return nil
+			}
+
+			var cmd tea.Cmd
+			m.selector, cmd = m.selector.Update(key)
+
+			if key.Type != tea.KeyEnter {
+				return cmd
+			}
+
+			suggested := m.selector.Highlighted()
+			if suggested == nil {
+				return nil
+			}
+
+			filters, err := m.store.SavedFilters()
+			if err != nil {
+				return tea.Batch(m.focus.pop(), returnError(err))
+			}
+
+			for _, f := range filters {
+				if f.Name == suggested.Identifier {
+					return tea.Batch(m.focus.pop(), pickSavedFilter(f))
+				}
+			}
+
+			return m.focus.pop()
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) savedFiltersPopped() tea.Msg {
+	m.table.Focus()
+	m.selector.Reset()
+	return nil
+}
+
+func savedFilterSuggestions(filters []store.SavedFilter) []input.Suggestion {
+	var suggestions []input.Suggestion
+	for i, f := range filters {
+		title := fmt.Sprintf("%d %s", i+1, f.Name)
+		if f.Query != "" {
+			title += "  /" + f.Query
+		}
+		suggestions = append(suggestions, input.Suggestion{
+			Identifier: f.Name,
+			Title:      title,
+		})
+	}
+	return suggestions
+}
+
+// NOTE: picked filters are applied once the key is handled, switching views
+// in between would let the project table act on the same key
+func pickSavedFilter(f store.SavedFilter) tea.Cmd {
+	return func() tea.Msg {
+		return savedFilterMsg(f)
+	}
+}
+
+func (m *Model) applySavedFilter(f store.SavedFilter) tea.Cmd {
+	err := m.store.SetSort(f.SortMode, f.Descending)
+	if err != nil {
+		return returnError(err)
+	}
+	m.updateTableCols()
+
+	m.input.SetValue("")
+	if f.Query != "" {
+		m.input.SetValue("/" + f.Query)
+	}
+	m.applyFilter()
+
+	if f.ProjectID != "" {
+		cmd := m.setView(ViewProject, withSelectedProject(f.ProjectID), withCursorAtIssue(0))
+
+		err := m.selectProjectRow(f.ProjectID)
+		if err != nil {
+			return returnError(err)
+		}
+
+		return cmd
+	}
+
+	if m.currView == ViewProject {
+		return m.setView(ViewAll)
+	}
+
+	return m.updateTables(withCursorAtIssue(0))
+}
@@ -5,17 +5,25 @@ import (
 	"fmt"
 	"log"
 	"os/exec"
+	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sayedmurtaza24/tinear/linear/models"
 	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/git"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/input"
 )
 
+const noMilestone = "no-milestone"
+
 func forceUpdate() tea.Msg {
 	return struct{}{}
 }
@@ -27,7 +35,7 @@ func returnError(err error) tea.Cmd {
 func (m *Model) handleSortMode(key tea.KeyMsg) tea.Cmd {
 	switch m.focus.current() {
 	case FocusIssues:
-		if key.String() != "s" {
+		if !keymap.Matches(key, m.keys.Sort) {
 			return nil
 		}
 		onPop := tea.Batch(
@@ -45,19 +53,27 @@ func (m *Model) handleSortMode(key tea.KeyMsg) tea.Cmd {
 		}
 
 	case FocusSort:
-		mappings := map[string]store.SortMode{
-			"p": store.SortModeProject,
-			"t": store.SortModeTitle,
-			"a": store.SortModeAssignee,
-			"e": store.SortModeState,
-			"r": store.SortModePrio,
-			"g": store.SortModeAge,
-			"m": store.SortModeTeam,
-			"s": store.SortModeSmart,
-		}
-
-		sortMode, ok := mappings[key.String()]
-		if !ok {
+		var sortMode store.SortMode
+		switch {
+		case keymap.Matches(key, m.keys.SortBy.Project):
+			sortMode = store.SortModeProject
+		case keymap.Matches(key, m.keys.SortBy.Title):
+			sortMode = store.SortModeTitle
+		case keymap.Matches(key, m.keys.SortBy.Assignee):
+			sortMode = store.SortModeAssignee
+		case keymap.Matches(key, m.keys.SortBy.State):
+			sortMode = store.SortModeState
+		case keymap.Matches(key, m.keys.SortBy.Prio):
+			sortMode = store.SortModePrio
+		case keymap.Matches(key, m.keys.SortBy.Age):
+			sortMode = store.SortModeAge
+		case keymap.Matches(key, m.keys.SortBy.Team):
+			sortMode = store.SortModeTeam
+		case keymap.Matches(key, m.keys.SortBy.Milestone):
+			sortMode = store.SortModeMilestone
+		case keymap.Matches(key, m.keys.SortBy.Smart):
+			sortMode = store.SortModeSmart
+		default:
 			return nil
 		}
 
@@ -79,12 +95,12 @@ func (m *Model) handleFilter(key tea.KeyMsg) (cmd tea.Cmd) {
 			return m.updateTables()
 		}
 
-		if key.String() == "/" {
+		if keymap.Matches(key, m.keys.Filter) {
 			if m.focus.push(FocusFilter, onPop) {
-				m.input.SetValue("")
-				m.input.Focus()
-				m.input, cmd = m.input.Update(key)
-				return cmd
+				// NOTE: the filter key may be rebound, the query always starts with /
+				m.input.SetValue("/")
+				m.input.CursorEnd()
+				return m.input.Focus()
 			}
 		}
 	case FocusFilter:
@@ -93,18 +109,34 @@ func (m *Model) handleFilter(key tea.KeyMsg) (cmd tea.Cmd) {
 		}
 
 		m.input, cmd = m.input.Update(key)
+		m.applyFilter()
 
 		return tea.Batch(cmd, m.updateTables(withDebounce()))
 	}
 	return nil
 }
 
+// applyFilter parses the filter bar into the query of the store, while it
+// doesn't parse the last query that did stays in place.
+func (m *Model) applyFilter() {
+	query, err := store.ParseQuery(strings.TrimPrefix(m.input.Value(), "/"))
+
+	var queryErr *store.QueryError
+	if errors.As(err, &queryErr) {
+		m.filterErr = queryErr
+		return
+	}
+
+	m.filterErr = nil
+	m.store.SetQuery(query)
+}
+
 func (m *Model) handleBookmark(key tea.KeyMsg) tea.Cmd {
 	if m.focus.current() != FocusIssues {
 		return nil
 	}
 
-	if key.String() != "b" {
+	if !keymap.Matches(key, m.keys.Bookmark) {
 		return nil
 	}
 
@@ -125,41 +157,73 @@ func (m *Model) handleBookmark(key tea.KeyMsg) tea.Cmd {
 	return m.updateTables(withSelectedIssue(selected))
 }
 
-func (m *Model) handleOpen(key tea.KeyMsg) tea.Cmd {
-	if m.focus.current() != FocusIssues && m.focus.current() != FocusHover {
-		return nil
+func openInBrowser(url string) error {
+	var cmd string
+	var args []string
+
+	switch runtime.GOOS {
+	case "windows":
+		cmd = "cmd"
+		args = []string{"/c", "start"}
+	case "darwin":
+		cmd = "open"
+	default:
+		cmd = "xdg-open"
 	}
+	args = append(args, url)
+	return exec.Command(cmd, args...).Start()
+}
+
+func (m *Model) issueURL(identifier string) string {
+	linearBaseURL := "https://linear.app"
+	urlKey := m.store.Current().Org.URLKey
 
-	if key.String() != "o" {
+	return fmt.Sprintf("%s/%s/issue/%s", linearBaseURL, urlKey, identifier)
+}
+
+func (m *Model) handleOpen(key tea.KeyMsg) tea.Cmd {
+	if !keymap.Matches(key, m.keys.Open) {
 		return nil
 	}
 
-	issue, err := m.store.Issue(m.table.SelectedRow())
-	if err != nil {
-		return returnError(err)
-	}
+	switch m.focus.current() {
+	case FocusIssues, FocusHover:
+		if m.hoveredProject != nil {
+			return nil
+		}
 
-	open := func(url string) error {
-		var cmd string
-		var args []string
+		issueID := m.table.SelectedRow()
+		if m.currView == ViewBoard {
+			issueID = m.board.selected()
+		}
 
-		switch runtime.GOOS {
-		case "windows":
-			cmd = "cmd"
-			args = []string{"/c", "start"}
-		case "darwin":
-			cmd = "open"
-		default:
-			cmd = "xdg-open"
+		issue, err := m.store.Issue(issueID)
+		if err != nil {
+			return returnError(err)
 		}
-		args = append(args, url)
-		return exec.Command(cmd, args...).Start()
-	}
 
-	linearBaseURL := "https://linear.app"
-	urlKey := m.store.Current().Org.URLKey
+		openInBrowser(m.issueURL(issue.Identifier))
+
+	case FocusBoard:
+		if card := m.board.card(); card != nil {
+			openInBrowser(m.issueURL(card.Identifier))
+		}
 
-	open(fmt.Sprintf("%s/%s/issue/%s", linearBaseURL, urlKey, issue.Identifier))
+	case FocusDetail:
+		if m.detail.issue != nil {
+			openInBrowser(m.issueURL(m.detail.issue.Identifier))
+		}
+
+	case FocusInbox:
+		notification, err := m.store.Notification(m.inboxTable.SelectedRow())
+		if err != nil {
+			return returnError(err)
+		}
+
+		if notification.IssueIdentifier != "" {
+			openInBrowser(m.issueURL(notification.IssueIdentifier))
+		}
+	}
 
 	return nil
 }
@@ -167,175 +231,983 @@ func (m *Model) handleOpen(key tea.KeyMsg) tea.Cmd {
 func (m *Model) handleHover(key tea.KeyMsg) tea.Cmd {
 	switch m.focus.current() {
 	case FocusIssues:
-		if key.String() != "K" {
+		if !keymap.Matches(key, m.keys.Hover) {
+			return nil
+		}
+		issue, err := m.store.Issue(m.table.SelectedRow())
+		if err != nil {
+			return returnError(err)
+		}
+		return m.hoverIssue(issue)
+
+	case FocusBoard:
+		if !keymap.Matches(key, m.keys.Hover) {
+			return nil
+		}
+		card := m.board.card()
+		if card == nil {
+			return nil
+		}
+		issue := *card
+		return m.hoverIssue(&issue)
+
+	case FocusProjects:
+		if !keymap.Matches(key, m.keys.Hover) {
 			return nil
 		}
 		onPop := func() tea.Msg {
-			m.hovered = nil
-			m.table.Focus()
+			m.hoveredProject = nil
+			m.prjTable.Focus()
 			return forceUpdate()
 		}
 		if m.focus.push(FocusHover, onPop) {
-			issue, err := m.store.Issue(m.table.SelectedRow())
+			project, err := m.store.Project(m.prjTable.SelectedRow())
 			if err != nil {
 				return returnError(err)
 			}
-			m.hovered = issue
-			m.table.Blur()
+			m.hoveredProject = project
+			m.prjTable.Blur()
 		}
 
 	case FocusHover:
-		if key.String() == "o" || key.Type == tea.KeyEsc {
+		if keymap.Matches(key, m.keys.Open) || key.Type == tea.KeyEsc {
 			return nil
 		}
-		return m.focus.pop()
+		return m.focus.pop()
+	}
+
+	return nil
+}
+
+func (m *Model) hoverIssue(issue *store.Issue) tea.Cmd {
+	onPop := func() tea.Msg {
+		m.hovered = nil
+		m.table.Focus()
+		return forceUpdate()
+	}
+	if m.focus.push(FocusHover, onPop) {
+		m.hovered = issue
+		m.table.Blur()
+	}
+	return nil
+}
+
+func (m *Model) handleClose(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues, FocusProjects, FocusCustomViews, FocusInbox, FocusBoard:
+	default:
+		return nil
+	}
+
+	if !keymap.Matches(key, m.keys.Quit) {
+		return nil
+	}
+
+	return tea.Quit
+}
+
+func (m *Model) handleFocus(key tea.KeyMsg) tea.Cmd {
+	if key.Type != tea.KeyEscape {
+		return nil
+	}
+
+	// NOTE: special filter escape layer
+	if m.input.Value() != "" {
+		m.input.SetValue("")
+		m.applyFilter()
+		if m.focus.current() != FocusFilter {
+			return m.updateTables()
+		}
+	}
+
+	return m.focus.pop()
+}
+
+func (m *Model) handleProjectSelection(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusProjects {
+		return nil
+	}
+
+	if key.Type != tea.KeyEnter {
+		return nil
+	}
+
+	err := m.selectProjectRow(m.prjTable.SelectedRow())
+	if err != nil {
+		return returnError(err)
+	}
+
+	shiftFocus := func() tea.Msg {
+		m.prjTable.Focus()
+		m.table.Blur()
+		return nil
+	}
+	onPop := tea.Batch(shiftFocus, m.updateTables())
+
+	if m.focus.push(FocusIssues, onPop) {
+		m.prjTable.Blur()
+		m.table.Focus()
+	}
+
+	return m.updateTables()
+}
+
+// selectProjectRow narrows the issues down to the project or milestone
+// under the cursor in the projects sidebar.
+func (m *Model) selectProjectRow(selectedID string) error {
+	projects, err := m.store.Projects()
+	if err != nil {
+		return err
+	}
+
+	milestones, err := m.store.Milestones()
+	if err != nil {
+		return err
+	}
+
+	for _, prj := range projects {
+		if prj.ID == selectedID {
+			m.store.SetProject(&prj)
+			return nil
+		}
+	}
+
+	for _, milestone := range milestones {
+		if milestone.ID != selectedID {
+			continue
+		}
+		for _, prj := range projects {
+			if prj.ID == milestone.ProjectID {
+				m.store.SetProject(&prj)
+				m.store.SetMilestone(&milestone)
+				return nil
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m *Model) handleCustomViewSelection(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusCustomViews {
+		return nil
+	}
+
+	if key.Type != tea.KeyEnter {
+		return nil
+	}
+
+	if m.store.Current().CustomView == nil {
+		return nil
+	}
+
+	shiftFocus := func() tea.Msg {
+		m.viewsTable.Focus()
+		m.table.Blur()
+		return nil
+	}
+	onPop := tea.Batch(shiftFocus, m.updateTables())
+
+	if m.focus.push(FocusIssues, onPop) {
+		m.viewsTable.Blur()
+		m.table.Focus()
+	}
+
+	return m.updateTables()
+}
+
+func (m *Model) selectCustomView(view *store.CustomView) tea.Cmd {
+	m.store.SetCustomView(view)
+
+	if view == nil || view.Local() {
+		return m.updateTables(withCursorAtIssue(0))
+	}
+
+	// NOTE: the view's filter can't be run locally, so linear has to tell us
+	return tea.Batch(
+		m.table.SetLoading(true),
+		m.updateTables(withCursorAtIssue(0)),
+		m.client.GetCustomViewIssues(*view, nil),
+	)
+}
+
+func (m *Model) handleViews(key tea.KeyMsg) tea.Cmd {
+	if !keymap.Matches(key, m.keys.Views) {
+		return nil
+	}
+
+	switch m.focus.current() {
+	case FocusIssues, FocusProjects, FocusCustomViews, FocusInbox, FocusBoard:
+	default:
+		return nil
+	}
+
+	switch m.currView {
+	case ViewAll:
+		return m.setView(ViewBoard)
+	case ViewBoard:
+		return m.setView(ViewProject)
+	case ViewProject:
+		return m.setView(ViewCustom)
+	case ViewCustom:
+		return m.setView(ViewInbox)
+	case ViewInbox:
+		return m.setView(ViewArchive)
+	case ViewArchive:
+		return m.setView(ViewAll)
+	}
+
+	return nil
+}
+
+func (m *Model) setView(v view, opts ...updateTablesOptFunc) tea.Cmd {
+	m.table.SetLoading(true)
+
+	projects, err := m.store.Projects()
+	if err != nil {
+		return returnError(err)
+	}
+
+	// NOTE: the selected issue never survives moving in or out of the archive
+	archiveToggled := (m.currView == ViewArchive) != (v == ViewArchive)
+
+	// the card under the cursor is where the issue table picks up
+	if m.currView == ViewBoard && v != ViewBoard {
+		if selected := m.board.selected(); selected != "" {
+			m.table.SetSelectedRow(selected)
+		}
+	}
+
+	m.currView = v
+	m.store.SetArchive(v == ViewArchive)
+
+	switch v {
+	case ViewProject:
+		m.focus = []focusStackItem{{mode: FocusProjects}}
+
+		m.store.SetCustomView(nil)
+		if len(projects) > 0 {
+			m.store.SetProject(&projects[0])
+		}
+		m.resizeTables(m.width)
+		m.prjTable.Focus()
+		m.table.Blur()
+		m.inboxTable.Blur()
+		m.viewsTable.Blur()
+		m.viewsTable.SetOnMove(nil)
+		m.prjTable.SetOnMove(func(selectedID string) tea.Cmd {
+			err := m.selectProjectRow(selectedID)
+			if err != nil {
+				return returnError(err)
+			}
+			return m.updateTables(withCursorAtIssue(0))
+		})
+
+		issue, err := m.store.Issue(m.table.SelectedRow())
+		if err != nil {
+			return returnError(err)
+		}
+
+		if issue.Project.ID != "" {
+			m.store.SetProject(&issue.Project)
+			opts = append([]updateTablesOptFunc{withSelectedProject(issue.Project.ID), withSelectedIssue(issue.ID)}, opts...)
+			return m.updateTables(opts...)
+		}
+
+		return m.updateTables(append([]updateTablesOptFunc{withCursorAtIssue(0)}, opts...)...)
+
+	case ViewCustom:
+		m.focus = []focusStackItem{{mode: FocusCustomViews}}
+
+		customViews, err := m.store.CustomViews()
+		if err != nil {
+			return returnError(err)
+		}
+
+		m.store.SetProject(nil)
+		m.resizeTables(m.width)
+		m.viewsTable.Focus()
+		m.table.Blur()
+		m.prjTable.Blur()
+		m.inboxTable.Blur()
+		m.prjTable.SetOnMove(nil)
+		m.viewsTable.SetOnMove(func(selectedID string) tea.Cmd {
+			for _, customView := range customViews {
+				if customView.ID == selectedID {
+					return m.selectCustomView(&customView)
+				}
+			}
+			return nil
+		})
+
+		if len(customViews) == 0 {
+			m.store.SetCustomView(nil)
+			return m.updateTables(opts...)
+		}
+
+		opts = append([]updateTablesOptFunc{withSelectedCustomView(customViews[0].ID)}, opts...)
+
+		return tea.Batch(m.selectCustomView(&customViews[0]), m.updateTables(opts...))
+
+	case ViewInbox:
+		m.focus = []focusStackItem{{mode: FocusInbox}}
+
+		m.store.SetProject(nil)
+		m.store.SetCustomView(nil)
+		m.resizeTables(m.width)
+		m.inboxTable.Focus()
+		m.table.Blur()
+		m.prjTable.Blur()
+		m.viewsTable.Blur()
+		m.prjTable.SetOnMove(nil)
+		m.viewsTable.SetOnMove(nil)
+
+		return m.updateTables(opts...)
+
+	case ViewBoard:
+		m.focus = []focusStackItem{{mode: FocusBoard}}
+
+		m.store.SetProject(nil)
+		m.store.SetCustomView(nil)
+		m.table.Blur()
+		m.prjTable.Blur()
+		m.viewsTable.Blur()
+		m.inboxTable.Blur()
+		m.prjTable.SetOnMove(nil)
+		m.viewsTable.SetOnMove(nil)
+
+		teams, err := m.store.Teams()
+		if err != nil {
+			return returnError(err)
+		}
+
+		// the board starts on the team of the selected issue
+		selected := m.table.SelectedRow()
+		if issue, err := m.store.Issue(selected); err == nil && issue.Team.ID != "" {
+			m.board.team = issue.Team
+		} else if len(teams) > 0 {
+			m.board.team = teams[0]
+		}
+
+		return m.updateTables(append([]updateTablesOptFunc{withSelectedIssue(selected)}, opts...)...)
+
+	default:
+		m.focus = []focusStackItem{{mode: FocusIssues}}
+
+		m.store.SetProject(nil)
+		m.store.SetCustomView(nil)
+		m.resizeTables(m.width)
+		m.table.Focus()
+		m.prjTable.Blur()
+		m.viewsTable.Blur()
+		m.inboxTable.Blur()
+		m.prjTable.SetOnMove(nil)
+		m.viewsTable.SetOnMove(nil)
+
+		if archiveToggled {
+			return m.updateTables(append([]updateTablesOptFunc{withCursorAtIssue(0)}, opts...)...)
+		}
+
+		return m.updateTables(append([]updateTablesOptFunc{withSelectedIssue(m.table.SelectedRow())}, opts...)...)
+	}
+}
+
+func snoozeUntil(option string) time.Time {
+	now := time.Now()
+	morning := func(t time.Time) time.Time {
+		return time.Date(t.Year(), t.Month(), t.Day(), 9, 0, 0, 0, t.Location())
+	}
+
+	switch option {
+	case "1h":
+		return now.Add(time.Hour)
+	case "3h":
+		return now.Add(3 * time.Hour)
+	case "week":
+		daysToMonday := (8 - int(now.Weekday())) % 7
+		if daysToMonday == 0 {
+			daysToMonday = 7
+		}
+		return morning(now.AddDate(0, 0, daysToMonday))
+	default:
+		return morning(now.AddDate(0, 0, 1))
+	}
+}
+
+func (m *Model) handleInbox(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusInbox:
+		selected := m.inboxTable.SelectedRows()
+
+		switch {
+		case keymap.Matches(key, m.keys.MarkRead):
+			notifications, err := m.store.Notifications()
+			if err != nil {
+				return returnError(err)
+			}
+
+			selectedMap := make(map[string]struct{})
+			for _, id := range selected {
+				selectedMap[id] = struct{}{}
+			}
+
+			var read []store.Notification
+			markRead := false
+			for _, notification := range notifications {
+				if _, ok := selectedMap[notification.ID]; !ok {
+					continue
+				}
+				read = append(read, notification)
+				markRead = markRead || notification.Unread()
+			}
+
+			onFail := func() tea.Msg {
+				err := m.store.StoreNotifications(read)
+				if err != nil {
+					return err
+				}
+				return m.updateTables()
+			}
+
+			err = m.store.SetNotificationsRead(markRead, selected...)
+			if err != nil {
+				return returnError(err)
+			}
+
+			m.inboxTable.SetVisualMode(false)
+
+			return tea.Batch(
+				m.client.UpdateNotifications(selected, onFail, client.WithRead(markRead)),
+				m.updateTables(),
+			)
+
+		case keymap.Matches(key, m.keys.Snooze):
+			onPop := func() tea.Msg {
+				m.inboxTable.Focus()
+				m.selector.Reset()
+				return nil
+			}
+
+			if m.focus.push(FocusSelector, tea.Batch(onPop, m.updateTables())) {
+				m.inboxTable.Blur()
+				m.selector.SetSuggestions([]input.Suggestion{
+					{Identifier: "1h", Title: "1 hour"},
+					{Identifier: "3h", Title: "3 hours"},
+					{Identifier: "tomorrow", Title: "tomorrow"},
+					{Identifier: "week", Title: "next week"},
+				})
+				m.selectorMode = SelectorModeSnooze
+			}
+
+		case key.Type == tea.KeyEnter:
+			notification, err := m.store.Notification(m.inboxTable.SelectedRow())
+			if err != nil {
+				return returnError(err)
+			}
+
+			if notification.IssueID == "" {
+				return nil
+			}
+
+			var cmds []tea.Cmd
+
+			if notification.Unread() {
+				err = m.store.SetNotificationsRead(true, notification.ID)
+				if err != nil {
+					return returnError(err)
+				}
+				cmds = append(cmds, m.client.UpdateNotifications(
+					[]string{notification.ID},
+					nil,
+					client.WithRead(true),
+				))
+			}
+
+			if _, err := m.store.Issue(notification.IssueID); err != nil {
+				// NOTE: issue isn't synced locally, so the best we can do is the browser
+				openInBrowser(m.issueURL(notification.IssueIdentifier))
+				return tea.Batch(append(cmds, m.updateTables())...)
+			}
+
+			cmds = append(cmds, m.setView(ViewAll, withSelectedIssue(notification.IssueID)))
+
+			return tea.Batch(cmds...)
+		}
+
+	case FocusSelector:
+		if m.selectorMode != SelectorModeSnooze {
+			return nil
+		}
+
+		var cmd tea.Cmd
+		m.selector, cmd = m.selector.Update(key)
+
+		if key.Type != tea.KeyEnter {
+			return cmd
+		}
+
+		suggested := m.selector.Highlighted()
+		if suggested == nil {
+			return nil
+		}
+
+		selected := m.inboxTable.SelectedRows()
+		until := snoozeUntil(suggested.Identifier)
+
+		err := m.store.SnoozeNotifications(until, selected...)
+		if err != nil {
+			return returnError(err)
+		}
+
+		m.inboxTable.SetVisualMode(false)
+
+		return tea.Batch(
+			m.focus.pop(),
+			m.client.UpdateNotifications(selected, m.client.GetNotifications(nil), client.WithSnoozedUntil(until)),
+		)
+	}
+
+	return nil
+}
+
+func (m *Model) handleBranch(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusIssues {
+		return nil
+	}
+
+	if !keymap.Matches(key, m.keys.Branch, m.keys.StartBranch) {
+		return nil
+	}
+
+	issue, err := m.store.Issue(m.table.SelectedRow())
+	if err != nil {
+		return returnError(err)
+	}
+
+	if issue.BranchName == "" {
+		return returnError(fmt.Errorf("%s has no branch name synced yet", issue.Identifier))
+	}
+
+	start := keymap.Matches(key, m.keys.StartBranch)
+
+	// NOTE: checkouts can take a while with hooks or large repos
+	return func() tea.Msg {
+		_, err := git.Checkout(issue.BranchName)
+		if err != nil {
+			return fmt.Errorf("couldn't check out branch of %s: %w", issue.Identifier, err)
+		}
+		return checkedOutMsg{issue: *issue, start: start}
+	}
+}
+
+// checkedOutMsg is sent once the branch of the issue is checked out, start
+// is whether the issue should be started too.
+type checkedOutMsg struct {
+	issue store.Issue
+	start bool
+}
+
+func (m *Model) checkedOut(msg checkedOutMsg) tea.Cmd {
+	if !msg.start {
+		return nil
+	}
+
+	err := m.store.SetWorkingOn(msg.issue.ID)
+	if err != nil {
+		return returnError(err)
+	}
+
+	return tea.Batch(m.startIssue(msg.issue), m.updateTables(withSelectedIssue(msg.issue.ID)))
+}
+
+func (m *Model) handleWorkingOn(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusIssues {
+		return nil
+	}
+
+	if !keymap.Matches(key, m.keys.WorkingOn) {
+		return nil
+	}
+
+	issueID := m.table.SelectedRow()
+	if issueID == m.store.Current().Org.WorkingOnIssueID {
+		issueID = ""
+	}
+
+	err := m.store.SetWorkingOn(issueID)
+	if err != nil {
+		return returnError(err)
+	}
+
+	return m.updateTables(withSelectedIssue(m.table.SelectedRow()))
+}
+
+// handleRemove archives, deletes or restores the selected issues once the
+// confirmation prompt is accepted.
+func (m *Model) handleRemove(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusIssues {
+		return nil
+	}
+
+	issueIDs := m.table.SelectedRows()
+	if len(issueIDs) == 0 || slices.Contains(issueIDs, "") {
+		return nil
+	}
+
+	var action string
+	var confirm func(issueIDs []string) tea.Cmd
+
+	switch {
+	default:
+		return nil
+	case keymap.Matches(key, m.keys.Archive):
+		if m.currView == ViewArchive {
+			return nil
+		}
+		action, confirm = "archive", m.archiveIssues
+	case keymap.Matches(key, m.keys.Delete):
+		if m.currView == ViewArchive {
+			return nil
+		}
+		action, confirm = "delete", m.deleteIssues
+	case keymap.Matches(key, m.keys.Restore):
+		if m.currView != ViewArchive {
+			return nil
+		}
+		action, confirm = "restore", m.restoreIssues
+	}
+
+	noun := "issues"
+	if len(issueIDs) == 1 {
+		noun = "issue"
+	}
+
+	return m.askConfirm(fmt.Sprintf("%s %d %s", action, len(issueIDs), noun), nil, func() tea.Cmd {
+		m.table.SetVisualMode(false)
+		return confirm(issueIDs)
+	})
+}
+
+// askConfirm opens a yes/no prompt, details are listed below the choices.
+func (m *Model) askConfirm(title string, details []string, confirm func() tea.Cmd) tea.Cmd {
+	onPop := func() tea.Msg {
+		m.table.Focus()
+		m.selector.Reset()
+		m.confirm = nil
+		return nil
+	}
+
+	if !m.focus.push(FocusSelector, onPop) {
+		return nil
+	}
+
+	suggestions := []input.Suggestion{
+		{Identifier: "yes", Title: title, Color: m.theme.EditField},
+		{Identifier: "no", Title: "cancel"},
+	}
+	for _, detail := range details {
+		suggestions = append(suggestions, input.Suggestion{Title: detail, Color: m.theme.Muted})
+	}
+
+	m.table.Blur()
+	m.selector.SetSuggestions(suggestions)
+	m.selectorMode = SelectorModeConfirm
+	m.confirm = confirm
+
+	return nil
+}
+
+func (m *Model) handleConfirm(key tea.KeyMsg) tea.Cmd {
+	if m.focus.current() != FocusSelector || m.selectorMode != SelectorModeConfirm {
+		return nil
+	}
+
+	var cmd tea.Cmd
+	m.selector, cmd = m.selector.Update(key)
+
+	if key.Type != tea.KeyEnter {
+		return cmd
+	}
+
+	suggested := m.selector.Highlighted()
+	if suggested == nil || suggested.Identifier != "yes" || m.confirm == nil {
+		return m.focus.pop()
+	}
+
+	confirm := m.confirm
+
+	return tea.Batch(m.focus.pop(), confirm())
+}
+
+func (m *Model) archiveIssues(issueIDs []string) tea.Cmd {
+	err := m.store.ArchiveIssues(issueIDs...)
+	if err != nil {
+		return returnError(err)
+	}
+
+	onFail := func() tea.Msg {
+		err := m.store.RestoreIssues(issueIDs...)
+		if err != nil {
+			return err
+		}
+		return m.updateTables()
 	}
 
-	return nil
+	return tea.Batch(
+		m.updateTables(),
+		m.client.ArchiveIssues(issueIDs, onFail),
+	)
 }
 
-func (m *Model) handleClose(key tea.KeyMsg) tea.Cmd {
-	if m.focus.current() != FocusIssues && m.focus.current() != FocusProjects {
-		return nil
+func (m *Model) deleteIssues(issueIDs []string) tea.Cmd {
+	err := m.store.TrashIssues(issueIDs...)
+	if err != nil {
+		return returnError(err)
 	}
 
-	if key.String() != "q" {
-		return nil
+	onFail := func() tea.Msg {
+		err := m.store.RestoreIssues(issueIDs...)
+		if err != nil {
+			return err
+		}
+		return m.updateTables()
 	}
 
-	return tea.Quit
+	return tea.Batch(
+		m.updateTables(),
+		m.client.DeleteIssues(issueIDs, onFail),
+	)
 }
 
-func (m *Model) handleFocus(key tea.KeyMsg) tea.Cmd {
-	if key.Type != tea.KeyEscape {
-		return nil
+func (m *Model) restoreIssues(issueIDs []string) tea.Cmd {
+	issues, err := m.store.Issues(issueIDs...)
+	if err != nil {
+		return returnError(err)
 	}
 
-	// NOTE: special filter escape layer
-	if m.input.Value() != "" {
-		m.input.SetValue("")
-		if m.focus.current() != FocusFilter {
-			return m.updateTables()
-		}
+	err = m.store.RestoreIssues(issueIDs...)
+	if err != nil {
+		return returnError(err)
 	}
 
-	return m.focus.pop()
-}
+	onFail := func() tea.Msg {
+		var archived, trashed []string
+		for _, issue := range issues {
+			if issue.Trashed {
+				trashed = append(trashed, issue.ID)
+			} else {
+				archived = append(archived, issue.ID)
+			}
+		}
 
-func (m *Model) handleProjectSelection(key tea.KeyMsg) tea.Cmd {
-	if m.focus.current() != FocusProjects {
-		return nil
-	}
+		err := m.store.ArchiveIssues(archived...)
+		if err != nil {
+			return err
+		}
 
-	if key.Type != tea.KeyEnter {
-		return nil
+		err = m.store.TrashIssues(trashed...)
+		if err != nil {
+			return err
+		}
+
+		return m.updateTables()
 	}
 
-	projects, err := m.store.Projects()
+	return tea.Batch(
+		m.updateTables(),
+		m.client.UnarchiveIssues(issueIDs, onFail),
+	)
+}
+
+// startIssue moves an issue that wasn't started yet to the started state of
+// its team, preferring the one called "In Progress".
+func (m *Model) startIssue(issue store.Issue) tea.Cmd {
+	states, err := m.store.States(issue.Team.ID)
 	if err != nil {
 		return returnError(err)
 	}
 
-	var selectedProject, currProject *store.Project
-	for _, prj := range projects {
-		if prj.ID == m.prjTable.SelectedRow() {
-			selectedProject = &prj
+	var started *store.State
+	for i, state := range states {
+		if state.ID == issue.State.ID && state.Type != "backlog" && state.Type != "unstarted" && state.Type != "triage" {
+			return nil
+		}
+		if state.Type != "started" {
+			continue
+		}
+		if started == nil || state.Name == "In Progress" {
+			started = &states[i]
 		}
-	}
-	if selectedProject == nil {
-		// NOTE: shouldn't really happen
-		return tea.Quit
 	}
 
-	currProject = m.store.Current().Project
-
-	if currProject == nil || currProject.ID != selectedProject.ID {
-		m.store.SetProject(selectedProject)
+	if started == nil {
+		return returnError(fmt.Errorf("team %s has no started state", issue.Team.Name))
 	}
 
-	shiftFocus := func() tea.Msg {
-		m.prjTable.Focus()
-		m.table.Blur()
-		return nil
+	onFail := func() tea.Msg {
+		err := m.store.StoreIssues([]store.Issue{issue})
+		if err != nil {
+			return err
+		}
+		return m.updateTables()
 	}
-	onPop := tea.Batch(shiftFocus, m.updateTables())
 
-	if m.focus.push(FocusIssues, onPop) {
-		m.prjTable.Blur()
-		m.table.Focus()
+	err = m.store.UpdateIssues(store.UpdateIssueFieldState, started.ID, issue.ID)
+	if err != nil {
+		return returnError(err)
 	}
 
-	return m.updateTables()
+	return tea.Batch(
+		m.client.UpdateIssues([]string{issue.ID}, onFail, client.WithSetState(started.ID)),
+		m.updateTables(withSelectedIssue(issue.ID)),
+	)
 }
 
-func (m *Model) handleViews(key tea.KeyMsg) tea.Cmd {
-	if key.Type != tea.KeyTab {
+// scanCommits links the commits of the configured repositories to the
+// issues they mention.
+func (m *Model) scanCommits() tea.Cmd {
+	repos := m.config.Repositories
+	if len(repos) == 0 {
 		return nil
 	}
 
-	if m.focus.current() != FocusIssues && m.focus.current() != FocusProjects {
-		return nil
-	}
+	return func() tea.Msg {
+		since := time.Now().AddDate(0, -6, 0)
 
-	m.table.SetLoading(true)
+		var commits []store.Commit
+		for _, repo := range repos {
+			logged, err := git.Log(repo, since)
+			if err != nil {
+				return fmt.Errorf("couldn't scan commits of %s: %w", repo, err)
+			}
 
-	projects, err := m.store.Projects()
-	if err != nil {
-		return returnError(err)
+			for _, commit := range logged {
+				for _, identifier := range commit.Identifiers() {
+					commits = append(commits, store.Commit{
+						SHA:             commit.SHA,
+						Repo:            filepath.Base(repo),
+						Branch:          commit.Branch,
+						Author:          commit.Author,
+						Subject:         commit.Subject,
+						AuthoredAt:      commit.AuthoredAt,
+						IssueIdentifier: identifier,
+					})
+				}
+			}
+		}
+
+		err := m.store.StoreCommits(commits)
+		if err != nil {
+			return err
+		}
+
+		return m.updateTables()
 	}
+}
 
-	switch m.currView {
-	case ViewAll:
-		m.currView = ViewProject
-		m.focus = []focusStackItem{{mode: FocusProjects}}
+func (m *Model) handleWorkspace(key tea.KeyMsg) tea.Cmd {
+	switch m.focus.current() {
+	case FocusIssues, FocusProjects, FocusCustomViews, FocusInbox:
+		if !keymap.Matches(key, m.keys.Workspace) || len(m.config.Workspaces) < 2 {
+			return nil
+		}
 
-		if len(projects) > 0 {
-			m.store.SetProject(&projects[0])
+		onPop := func() tea.Msg {
+			m.selector.Reset()
+			return nil
 		}
-		m.table.SetWidth(m.width - projectsTableWidth)
-		m.prjTable.SetWidth(projectsTableWidth)
-		m.prjTable.Focus()
-		m.table.Blur()
-		m.prjTable.SetOnMove(func(selectedID string) tea.Cmd {
-			var selectedProject *store.Project
-			for _, prj := range projects {
-				if prj.ID == selectedID {
-					selectedProject = &prj
-				}
-			}
-			if selectedProject != nil {
-				m.store.SetProject(selectedProject)
+
+		if m.focus.push(FocusSelector, onPop) {
+			var suggestions []input.Suggestion
+			for _, ws := range m.config.Workspaces {
+				suggestions = append(suggestions, input.Suggestion{
+					Identifier: ws.Name,
+					Title:      ws.Name,
+					Selected:   ws.Name == m.client.Workspace(),
+				})
 			}
-			return m.updateTables(withCursorAtIssue(0))
-		})
+			m.selector.SetSuggestions(suggestions)
+			m.selectorMode = SelectorModeWorkspace
+		}
 
-		issue, err := m.store.Issue(m.table.SelectedRow())
-		if err != nil {
-			return returnError(err)
+	case FocusSelector:
+		if m.selectorMode != SelectorModeWorkspace {
+			return nil
 		}
 
-		if issue.Project.ID != "" {
-			m.store.SetProject(&issue.Project)
-			return m.updateTables(withSelectedProject(issue.Project.ID), withSelectedIssue(issue.ID))
+		var cmd tea.Cmd
+		m.selector, cmd = m.selector.Update(key)
+
+		if key.Type != tea.KeyEnter {
+			return cmd
 		}
 
-		return m.updateTables(withCursorAtIssue(0))
-	case ViewProject:
-		m.currView = ViewAll
-		m.focus = []focusStackItem{{mode: FocusIssues}}
+		suggested := m.selector.Highlighted()
+		if suggested == nil {
+			return nil
+		}
 
-		m.store.SetProject(nil)
-		m.table.SetWidth(m.width)
-		m.table.Focus()
-		m.prjTable.Blur()
-		m.prjTable.SetOnMove(nil)
+		if suggested.Identifier == m.client.Workspace() {
+			return m.focus.pop()
+		}
 
-		return m.updateTables(withSelectedIssue(m.table.SelectedRow()))
+		for _, ws := range m.config.Workspaces {
+			if ws.Name == suggested.Identifier {
+				return m.switchWorkspace(ws)
+			}
+		}
 	}
 
 	return nil
 }
 
+func (m *Model) switchWorkspace(ws config.Workspace) tea.Cmd {
+	orgs, err := m.store.Orgs()
+	if err != nil {
+		return returnError(err)
+	}
+
+	// NOTE: orgs of a workspace that was never synced are only known after GetMe
+	var orgID string
+	for _, org := range orgs {
+		if org.Workspace == ws.Name {
+			orgID = org.ID
+		}
+	}
+
+	m.selector.Reset()
+	m.input.SetValue("")
+	m.applyFilter()
+	m.hovered = nil
+	m.hoveredProject = nil
+
+	cmd := m.setView(ViewAll)
+
+	err = m.store.SetActiveOrg(orgID)
+	if err != nil {
+		return returnError(err)
+	}
+
+	m.client.Close()
+	m.client = client.New(ws)
+
+	m.syncing = true
+	m.unread = 0
+	m.workingOn = ""
+	m.remoteSearch = ""
+	m.searchingRemote = false
+	m.layouts = nil
+	m.collapsed = nil
+	m.updateTableRows(nil)
+	m.updateInboxTable(nil)
+
+	return tea.Batch(
+		cmd,
+		m.table.SetLoading(true),
+		m.client.GetMe(),
+	)
+}
+
 func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 	switch m.focus.current() {
 	case FocusIssues:
@@ -345,7 +1217,8 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 			return forceUpdate()
 		}
 
-		if key.String() != "e" {
+		// NOTE: the cursor may be on the header of a collapsed group
+		if !keymap.Matches(key, m.keys.Edit) || slices.Contains(m.table.SelectedRows(), "") {
 			return nil
 		}
 
@@ -364,10 +1237,10 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 			return nil
 		}
 
-		switch key.String() {
+		switch {
 		default:
 			return nil
-		case "p": // projects
+		case keymap.Matches(key, m.keys.EditField.Project):
 			mode = SelectorModeProject
 
 			projects, err := m.store.Projects()
@@ -382,7 +1255,7 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 					Color:      project.Color,
 				})
 			}
-		case "a": // assignee
+		case keymap.Matches(key, m.keys.EditField.Assignee):
 			mode = SelectorModeAssignee
 
 			users, err := m.store.Users()
@@ -396,7 +1269,7 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 					Title:      user.DisplayName,
 				})
 			}
-		case "e": // state
+		case keymap.Matches(key, m.keys.EditField.State):
 			mode = SelectorModeState
 
 			issues, err := m.store.Issues(m.table.SelectedRows()...)
@@ -427,17 +1300,53 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 					Color:      state.Color,
 				})
 			}
-		case "r": // prio
+		case keymap.Matches(key, m.keys.EditField.Prio):
 			mode = SelectorModePriority
 
 			suggestion = []input.Suggestion{
-				{Identifier: "0", Title: "No Priority", Color: "#555555"},
-				{Identifier: "1", Title: "Urgent", Color: "#e03a43"},
-				{Identifier: "2", Title: "High", Color: "#d47248"},
-				{Identifier: "3", Title: "Medium", Color: "#806b38"},
-				{Identifier: "4", Title: "Low", Color: "#4a4a4a"},
+				{Identifier: "0", Title: "No Priority", Color: m.theme.Priority.None},
+				{Identifier: "1", Title: "Urgent", Color: m.theme.Priority.Urgent},
+				{Identifier: "2", Title: "High", Color: m.theme.Priority.High},
+				{Identifier: "3", Title: "Medium", Color: m.theme.Priority.Medium},
+				{Identifier: "4", Title: "Low", Color: m.theme.Priority.Low},
+			}
+		case keymap.Matches(key, m.keys.EditField.Milestone):
+			mode = SelectorModeMilestone
+
+			issues, err := m.store.Issues(m.table.SelectedRows()...)
+			if err != nil {
+				return returnError(err)
+			}
+
+			var projectID string
+
+			for _, issue := range issues {
+				if projectID == "" {
+					projectID = issue.Project.ID
+				} else if projectID != issue.Project.ID {
+					return returnError(errors.New("issues selected are not of the same project"))
+				}
+			}
+
+			project, err := m.store.Project(projectID)
+			if err != nil {
+				return returnError(err)
+			}
+
+			suggestion = append(suggestion, input.Suggestion{
+				Identifier: noMilestone,
+				Title:      "(No Milestone)",
+				Color:      m.theme.Muted,
+			})
+
+			for _, milestone := range project.Milestones {
+				suggestion = append(suggestion, input.Suggestion{
+					Identifier: milestone.ID,
+					Title:      milestone.Name,
+					Color:      project.Color,
+				})
 			}
-		case "m": // team
+		case keymap.Matches(key, m.keys.EditField.Team):
 			mode = SelectorModeTeam
 
 			teams, err := m.store.Teams()
@@ -452,7 +1361,7 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 					Color:      team.Color,
 				})
 			}
-		case "l": // labels
+		case keymap.Matches(key, m.keys.EditField.Labels):
 			mode = SelectorModeLabels
 
 			issues, err := m.store.Issues(m.table.SelectedRows()...)
@@ -493,7 +1402,7 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 				})
 			}
 
-		case "t": // title
+		case keymap.Matches(key, m.keys.EditField.Title):
 			mode = SelectorModeTitle
 
 			issue, err := m.store.Issue(m.table.SelectedRow())
@@ -512,6 +1421,12 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 			m.selectorMode = mode
 		}
 	case FocusSelector:
+		switch m.selectorMode {
+		case SelectorModeSnooze, SelectorModeWorkspace, SelectorModeConfirm, SelectorModeExport,
+			SelectorModeSaveFilter, SelectorModeSavedFilters, SelectorModeGoTo:
+			return nil
+		}
+
 		m.selector, cmd = m.selector.Update(key)
 
 		if key.Type != tea.KeyEnter {
@@ -599,6 +1514,15 @@ func (m *Model) handleSelector(key tea.KeyMsg) (cmd tea.Cmd) {
 			}
 			updatedValue = suggested.Identifier
 
+		case SelectorModeMilestone:
+			updatedField = store.UpdateIssueFieldMilestone
+			updatedOpt = client.WithSetMilestone(suggested.Identifier)
+			updatedValue = suggested.Identifier
+			if suggested.Identifier == noMilestone {
+				updatedOpt = client.WithSetMilestone(models.NullString)
+				updatedValue = nil
+			}
+
 		case SelectorModeTeam:
 			updatedField = store.UpdateIssueFieldTeam
 			updatedOpt = client.WithSetTeam(suggested.Identifier)
@@ -652,13 +1576,23 @@ type (
 	updateTablesMsg struct {
 		issues        []store.Issue
 		projects      []store.Project
+		milestones    []store.ProjectMilestone
+		customViews   []store.CustomView
+		notifications []store.Notification
+		unread        int
+		workingOn     string
 		issue         string
 		project       string
+		customView    string
 		issueCursorAt int
+		view          view
+		columns       []store.TableColumn
+		states        []store.State
 	}
 	updateTablesOpt struct {
 		issue         string
 		project       string
+		customView    string
 		debounce      bool
 		updateColumns bool
 		cursorAt      int
@@ -666,9 +1600,12 @@ type (
 	updateTablesOptFunc func(opt *updateTablesOpt)
 )
 
+// withSelectedIssue moves the cursor to the issue, it wins over a cursor
+// position passed before it.
 func withSelectedIssue(selected string) updateTablesOptFunc {
 	return func(opt *updateTablesOpt) {
 		opt.issue = selected
+		opt.cursorAt = -1
 	}
 }
 
@@ -678,6 +1615,12 @@ func withSelectedProject(selected string) updateTablesOptFunc {
 	}
 }
 
+func withSelectedCustomView(selected string) updateTablesOptFunc {
+	return func(opt *updateTablesOpt) {
+		opt.customView = selected
+	}
+}
+
 func withDebounce() updateTablesOptFunc {
 	return func(opt *updateTablesOpt) {
 		opt.debounce = true
@@ -713,8 +1656,9 @@ func (m *Model) updateTables(opts ...updateTablesOptFunc) tea.Cmd {
 			}
 		}
 
-		if len(m.input.Value()) > 3 {
-			issues, err = m.store.SearchIssues(m.input.Value()[1:])
+		// NOTE: the filters of the query apply to both, only its text is searched
+		if query := m.store.Current().Query; query != nil && query.Text != "" {
+			issues, err = m.store.SearchIssues(query.Text)
 			if err != nil {
 				if errors.Is(err, store.ErrNoOrgSelected) {
 					return nil
@@ -736,12 +1680,63 @@ func (m *Model) updateTables(opts ...updateTablesOptFunc) tea.Cmd {
 			return err
 		}
 
+		milestones, err := m.store.Milestones()
+		if err != nil {
+			return err
+		}
+
+		customViews, err := m.store.CustomViews()
+		if err != nil {
+			return err
+		}
+
+		notifications, err := m.store.Notifications()
+		if err != nil {
+			return err
+		}
+
+		unread, err := m.store.UnreadNotifications()
+		if err != nil {
+			return err
+		}
+
+		var workingOn string
+		if issue, err := m.store.WorkingOn(); err == nil && issue != nil {
+			workingOn = issue.Identifier
+		}
+
+		v := m.currView
+		var columns []store.TableColumn
+		if name := layoutName(v); name != "" {
+			columns, err = m.store.TableColumns(name)
+			if err != nil {
+				return err
+			}
+		}
+
+		var states []store.State
+		if team := m.board.team.ID; v == ViewBoard && team != "" {
+			states, err = m.store.States(team)
+			if err != nil {
+				return err
+			}
+		}
+
 		return updateTablesMsg{
 			issues:        issues,
 			projects:      projects,
+			milestones:    milestones,
+			customViews:   customViews,
+			notifications: notifications,
+			unread:        unread,
+			workingOn:     workingOn,
 			issue:         options.issue,
 			project:       options.project,
+			customView:    options.customView,
 			issueCursorAt: options.cursorAt,
+			view:          v,
+			columns:       columns,
+			states:        states,
 		}
 	}
 }
@@ -756,43 +1751,111 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case error:
 		m.err = msg
+		m.searchingRemote = false
 		return m, nil
 
 	case tea.Cmd:
 		cmds = append(cmds, msg)
 
 	case tea.KeyMsg:
+		// NOTE: the prompt goes before the handlers opening it, otherwise the
+		// key asking for confirmation gets typed into it
+		cmds = append(cmds, m.handleConfirm(msg))
 		cmds = append(cmds, m.handleFilter(msg))
+		cmds = append(cmds, m.handleRemoteSearch(msg))
 		cmds = append(cmds, m.handleBookmark(msg))
+		cmds = append(cmds, m.handleBranch(msg))
+		cmds = append(cmds, m.handleWorkingOn(msg))
+		cmds = append(cmds, m.handleRemove(msg))
+		cmds = append(cmds, m.handleBulkEdit(msg))
+		cmds = append(cmds, m.handleExport(msg))
+		cmds = append(cmds, m.handleSavedFilters(msg))
+		cmds = append(cmds, m.handleGoTo(msg))
 		cmds = append(cmds, m.handleHover(msg))
 		cmds = append(cmds, m.handleOpen(msg))
 		cmds = append(cmds, m.handleSelector(msg))
 		cmds = append(cmds, m.handleSortMode(msg))
+		cmds = append(cmds, m.handleGroup(msg))
+		cmds = append(cmds, m.handleCollapse(msg))
+		cmds = append(cmds, m.handleBoard(msg))
+		cmds = append(cmds, m.handleDetail(msg))
+		cmds = append(cmds, m.handleColumns(msg))
 		cmds = append(cmds, m.handleClose(msg))
 		cmds = append(cmds, m.handleFocus(msg))
 		cmds = append(cmds, m.handleProjectSelection(msg))
+		cmds = append(cmds, m.handleCustomViewSelection(msg))
 		cmds = append(cmds, m.handleViews(msg))
+		cmds = append(cmds, m.handleInbox(msg))
+		cmds = append(cmds, m.handleWorkspace(msg))
+
+	case bulkEditedMsg:
+		cmds = append(cmds, m.bulkEdited(msg))
+
+	case checkedOutMsg:
+		cmds = append(cmds, m.checkedOut(msg))
+
+	case remoteSearchMsg:
+		cmds = append(cmds, m.searchRemote(string(msg)))
+
+	case client.SearchIssuesRes:
+		cmds = append(cmds, m.remoteSearched(msg))
+
+	case goToMsg:
+		cmds = append(cmds, m.goTo(string(msg)))
+
+	case client.GetIssueRes:
+		cmds = append(cmds, m.fetchedIssue(msg.Result))
+
+	case fetchActivityMsg:
+		cmds = append(cmds, m.fetchActivity(string(msg)))
+
+	case fetchedActivityMsg:
+		cmds = append(cmds, m.fetchedActivity(msg))
+
+	case savedFilterMsg:
+		cmds = append(cmds, m.applySavedFilter(store.SavedFilter(msg)))
 
 	case updateTablesMsg:
 		m.table.SetLoading(false)
+		if m.layouts == nil {
+			m.layouts = make(map[view][]store.TableColumn)
+		}
+		m.layouts[msg.view] = msg.columns
 		m.updateTableCols()
 		m.updateTableRows(msg.issues)
-		m.updateProjectsTable(msg.projects)
+		m.issues = msg.issues
+		m.updateProjectsTable(msg.projects, msg.milestones)
+		m.updateCustomViewsTable(msg.customViews)
+		m.updateInboxTable(msg.notifications)
+		m.unread = msg.unread
+		m.workingOn = msg.workingOn
 		if msg.issue != "" {
 			m.table.SetSelectedRow(msg.issue)
 		}
 		if msg.project != "" {
 			m.prjTable.SetSelectedRow(msg.project)
 		}
+		if msg.customView != "" {
+			m.viewsTable.SetSelectedRow(msg.customView)
+		}
 		if msg.issueCursorAt != -1 {
 			m.table.SetCursor(msg.issueCursorAt)
 		}
+		if msg.view == ViewBoard {
+			m.updateBoard(msg.states, msg.issues, msg.issue)
+		}
+		cmds = append(cmds, m.searchRemoteIfEmpty(msg.issues))
 
 	case client.UpdateIssuesResponse:
 		if !msg.Success {
 			cmds = append(cmds, msg.OnFailCommand)
 		}
 
+	case client.UpdateNotificationsResponse:
+		if !msg.Success {
+			cmds = append(cmds, msg.OnFailCommand)
+		}
+
 	case client.GetMeRes:
 		orgChanged, err := m.store.StoreOrg(msg.Result.Org)
 		if err != nil {
@@ -832,9 +1895,16 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.client.GetProjects(nil),
 				m.client.GetUsers(nil),
 				m.client.GetIssues(lastSync, teamIDs, nil),
+				m.client.GetNotifications(nil),
+				m.client.GetCustomViews(nil),
 			))
 		} else {
+			// NOTE: projects aren't synced incrementally, their progress and
+			// health change without any of their issues changing
+			cmds = append(cmds, m.client.GetProjects(nil))
 			cmds = append(cmds, m.client.GetIssues(m.store.Current().Org.SyncedAt, teamIDs, nil))
+			cmds = append(cmds, m.client.GetNotifications(nil))
+			cmds = append(cmds, m.client.GetCustomViews(nil))
 
 			issues, err := m.store.Issues()
 			if err != nil {
@@ -861,6 +1931,45 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, returnError(err)
 		}
 
+		if msg.After == nil {
+			cmds = append(cmds, m.updateTables())
+		}
+
+	case client.GetCustomViewsRes:
+		if msg.After != nil {
+			cmds = append(cmds, m.client.GetCustomViews(msg.After))
+		}
+		err := m.store.StoreCustomViews(msg.Result, msg.Reset)
+		if err != nil {
+			return m, returnError(err)
+		}
+
+		cmds = append(cmds, m.updateTables())
+
+	case client.GetCustomViewIssuesRes:
+		err := m.store.StoreCustomViewIssues(msg.ViewID, msg.Result, msg.Reset)
+		if err != nil {
+			return m, returnError(err)
+		}
+
+		current := m.store.Current().CustomView
+		if msg.After != nil && current != nil && current.ID == msg.ViewID {
+			cmds = append(cmds, m.client.GetCustomViewIssues(*current, msg.After))
+		}
+
+		cmds = append(cmds, m.updateTables())
+
+	case client.GetNotificationsRes:
+		if msg.After != nil {
+			cmds = append(cmds, m.client.GetNotifications(msg.After))
+		}
+		err := m.store.StoreNotifications(msg.Result)
+		if err != nil {
+			return m, returnError(err)
+		}
+
+		cmds = append(cmds, m.updateTables())
+
 	case client.GetIssuesRes:
 		teams, err := m.store.Teams()
 		if err != nil {
@@ -890,15 +1999,11 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		switch m.currView {
-		case ViewAll:
-			m.table.SetWidth(msg.Width)
-		case ViewProject:
-			m.prjTable.SetWidth(projectsTableWidth)
-			m.table.SetWidth(msg.Width - projectsTableWidth)
-		}
+		m.resizeTables(msg.Width)
 		m.table.SetHeight(msg.Height - 4)
 		m.prjTable.SetHeight(msg.Height - 5)
+		m.viewsTable.SetHeight(msg.Height - 5)
+		m.inboxTable.SetHeight(msg.Height - 4)
 		m.width = msg.Width
 		m.height = msg.Height
 	}
@@ -913,5 +2018,21 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		cmds = append(cmds, cmd)
 	}
 
+	if m.focus.current() == FocusCustomViews {
+		m.viewsTable, cmd = m.viewsTable.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	if m.focus.current() == FocusInbox {
+		m.inboxTable, cmd = m.inboxTable.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	switch msg.(type) {
+	case tea.KeyMsg, updateTablesMsg, tea.WindowSizeMsg:
+		cmds = append(cmds, m.syncDetail())
+	}
+	m.detail.lines = m.detailLines()
+
 	return m, tea.Batch(cmds...)
 }
@@ -0,0 +1,95 @@
+package dashboard
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sayedmurtaza24/tinear/pkg/client"
+	"github.com/sayedmurtaza24/tinear/pkg/config"
+	"github.com/sayedmurtaza24/tinear/pkg/store"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/keymap"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
+)
+
+func newTestModel(t *testing.T, issues int) *Model {
+	t.Helper()
+
+	s, err := store.New(t.TempDir() + "/tinear.db")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	team := store.Team{ID: "team", Name: "ENG", Color: "#5e6ad2"}
+	state := store.State{ID: "todo", Name: "Todo", Color: "#e2e2e2", TeamID: team.ID, Type: "unstarted"}
+
+	_, err = s.StoreOrg(store.Org{ID: "0b9f5c2e-org", Name: "Org", URLKey: "org", Workspace: "work"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.StoreTeams([]store.Team{team}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.StoreStates([]store.State{state}); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	var stored []store.Issue
+	for i := range issues {
+		stored = append(stored, store.Issue{
+			ID:         fmt.Sprint("issue-", i),
+			Identifier: fmt.Sprint("ENG-", i),
+			Title:      fmt.Sprint("Issue ", i),
+			Team:       team,
+			State:      state,
+			CreatedAt:  now,
+			UpdatedAt:  now,
+		})
+	}
+	if err := s.StoreIssues(stored); err != nil {
+		t.Fatal(err)
+	}
+
+	workspace := config.Workspace{Name: "work", APIKey: "key"}
+	m := New(s, client.New(workspace), &config.Config{Workspaces: []config.Workspace{workspace}}, keymap.Default(), theme.Dark)
+
+	m.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	m.Update(m.updateTables()())
+
+	return m
+}
+
+func TestDeleteConfirm(t *testing.T) {
+	m := newTestModel(t, 3)
+
+	if len(m.issues) != 3 {
+		t.Fatalf("expected 3 issues in the table, got %d", len(m.issues))
+	}
+
+	m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+
+	if m.focus.current() != FocusSelector || m.selectorMode != SelectorModeConfirm {
+		t.Fatalf("expected the confirm prompt to open, focus is %v", m.focus.current())
+	}
+	if value := m.selector.Value(); value != "" {
+		t.Fatalf("expected the prompt to be empty, got %q", value)
+	}
+
+	// NOTE: the commands aren't run, the delete request would fail and
+	// restore the issue
+	m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if m.focus.current() != FocusIssues {
+		t.Fatalf("expected the prompt to close, focus is %v", m.focus.current())
+	}
+
+	issues, err := m.store.Issues()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 2 {
+		t.Fatalf("expected 2 issues left, got %d", len(issues))
+	}
+}
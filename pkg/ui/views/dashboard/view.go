@@ -2,54 +2,52 @@ package dashboard
 
 import (
 	"fmt"
-	"math"
 	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
 	"github.com/sayedmurtaza24/tinear/pkg/store"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/atoms/hover"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/layouts"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/molecules/table"
 	"github.com/sayedmurtaza24/tinear/pkg/ui/text"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/theme"
 )
 
 const projectsTableWidth = 25
 
-func ageTextAndColor(issue store.Issue) (string, color.Color) {
-	createdAgeDays := -time.Until(issue.CreatedAt).Hours() / 24
+func ageTextAndColor(issue store.Issue, th theme.Theme) (string, color.Color) {
 	updatedAgeDays := -time.Until(issue.UpdatedAt).Hours() / 24
 
-	baseRed := 120.0
-	baseGreen := 120.0
-	baseBlue := 120.0
-
-	// Calculate brightness adjustment
-	brightnessFactor := -createdAgeDays*(0.04/3.0) + 0.7
-	brightnessFactor = math.Max(1, brightnessFactor) // Ensure brightness factor is at least 1
-
-	// Calculate blueness based on how recently the issue was updated
-	blueness := 0.0
+	// issues updated in the last 20 days are fresher the more recent
+	freshness := 0.0
 	if updatedAgeDays <= 20 {
-		blueness = (20 - updatedAgeDays) / 20
+		freshness = (20 - max(updatedAgeDays, 0)) / 20
 	}
 
-	// Apply brightness adjustment to all channels
-	adjustedRed := baseRed * brightnessFactor
-	adjustedGreen := (baseGreen + (255-baseGreen)*blueness*0.3) * brightnessFactor
-	adjustedBlue := (baseBlue + (255-baseBlue)*blueness*0.6) * brightnessFactor
+	ageColorHex, err := color.Mix(freshness, th.AgeOld, th.AgeFresh)
+	if err != nil {
+		ageColorHex = th.AgeOld
+	}
 
-	// Ensure the adjusted values do not go below the base color values
-	adjustedRed = math.Max(baseRed, math.Min(adjustedRed, 255))       // Clamp between baseRed and 255
-	adjustedGreen = math.Max(baseGreen, math.Min(adjustedGreen, 255)) // Clamp between baseGreen and 255
-	adjustedBlue = math.Max(baseBlue, math.Min(adjustedBlue, 255))    // Clamp between baseBlue and 255
+	return ageText(issue.CreatedAt), color.Focusable(ageColorHex, th.Blurred)
+}
 
-	ageColorHex := fmt.Sprintf("#%02x%02x%02x", uint8(adjustedRed), uint8(adjustedGreen), uint8(adjustedBlue))
+func removedTextAndColor(issue store.Issue, th theme.Theme) (string, string) {
+	if issue.Trashed {
+		return "deleted " + ageText(*issue.ArchivedAt), th.Deleted
+	}
+	return "archived " + ageText(*issue.ArchivedAt), th.Blurred
+}
 
+func ageText(createdAt time.Time) string {
 	var ageText string
 
-	age := -time.Until(issue.CreatedAt)
+	age := -time.Until(createdAt)
 	switch {
 	case age < time.Minute:
 		ageText = "<1m"
@@ -67,44 +65,81 @@ func ageTextAndColor(issue store.Issue) (string, color.Color) {
 		ageText = fmt.Sprintf("%dy", int(age.Hours()/24/7/30/12))
 	}
 
-	return ageText, color.Focusable(ageColorHex, "#888")
+	return ageText
 }
 
 func (m *Model) updateTableCols() {
-	defaultColor := color.Simple("#bbb")
+	defaultColor := color.Simple(m.theme.Header)
 
 	accentColor := func(sortable, assignable bool) color.Color {
 		if m.focus.current() == FocusSort && sortable {
-			return color.Simple("#3f98b5")
+			return color.Simple(m.theme.SortBy)
 		}
 		if m.focus.current() == FocusSelectorPre && assignable {
-			return color.Simple("#e3463b")
+			return color.Simple(m.theme.EditField)
 		}
 		return defaultColor
 	}
 
-	cols := []*table.Column{
-		table.NewColumn(text.KeymapText("project", defaultColor, 0, accentColor(true, true), text.B), 3, table.WithMaxWidth(20)),
-		table.NewColumn(text.KeymapText("title", defaultColor, 0, accentColor(true, true), text.B), 10, table.WithAutoFill()),
-		table.NewColumn(text.Colored("", defaultColor), 0, table.WithMaxWidth(4), table.WithMinWidth(4)),
-		table.NewColumn(text.KeymapText("assignee", defaultColor, 0, accentColor(true, true), text.B), 1, table.WithMaxWidth(10)),
-		table.NewColumn(text.KeymapText("state", defaultColor, 4, accentColor(true, true), text.B), 1, table.WithMaxWidth(10)),
-		table.NewColumn(text.KeymapText("prio", defaultColor, 1, accentColor(true, true), text.B), 0.5, table.WithMaxWidth(10)),
-		table.NewColumn(text.KeymapText("age", defaultColor, 1, accentColor(true, false), text.B), 0.5, table.WithMaxWidth(6)),
-		table.NewColumn(text.KeymapText("team", defaultColor, 3, accentColor(true, true), text.B), 1.5, table.WithMaxWidth(15)),
-		table.NewColumn(text.KeymapText("labels", defaultColor, 0, accentColor(false, true), text.B), 3, table.WithMaxWidth(40)),
+	// the key of the column is highlighted in its name, if the name has it
+	keymapTitle := func(name string, sortBy, editField key.Binding) text.Focusable {
+		binding := sortBy
+		if m.focus.current() == FocusSelectorPre {
+			binding = editField
+		}
+
+		for _, k := range binding.Keys() {
+			i := strings.Index(name, k)
+			if i == -1 || utf8.RuneCountInString(k) != 1 || !binding.Enabled() {
+				continue
+			}
+			return text.KeymapText(name, defaultColor, utf8.RuneCountInString(name[:i]), accentColor(sortBy.Enabled(), editField.Enabled()), text.B)
+		}
+
+		return text.Colored(name, defaultColor, text.B)
 	}
+
+	var cols []*table.Column
+	for _, tc := range m.layout() {
+		c, _ := columnByName(tc.Name)
+
+		var sortBy, editField key.Binding
+		if c.sortBy != nil {
+			sortBy = c.sortBy(m.keys)
+		}
+		if c.editField != nil {
+			editField = c.editField(m.keys)
+		}
+
+		title := keymapTitle(c.title, sortBy, editField)
+		if c.title == "" {
+			title = text.Colored("", defaultColor)
+		}
+
+		cols = append(cols, c.tableColumn(title, tc.Width))
+	}
+
 	prjColumn := []*table.Column{
 		table.NewColumn(text.Colored("projects", defaultColor, text.B), 1, table.WithAutoFill()),
+		table.NewColumn(text.Colored("", defaultColor), 0, table.WithMaxWidth(4), table.WithMinWidth(4)),
+		table.NewColumn(text.Colored("", defaultColor), 0, table.WithMaxWidth(4), table.WithMinWidth(4)),
 	}
-
-	if m.currView == ViewProject {
-		m.table.SetColumns(cols[1:])
-	} else {
-		m.table.SetColumns(cols)
+	viewsColumn := []*table.Column{
+		table.NewColumn(text.Colored("views", defaultColor, text.B), 1, table.WithAutoFill()),
+	}
+	inboxCols := []*table.Column{
+		table.NewColumn(text.Colored("", defaultColor), 0, table.WithMaxWidth(4), table.WithMinWidth(4)),
+		table.NewColumn(text.Colored("issue", defaultColor, text.B), 1, table.WithMaxWidth(10)),
+		table.NewColumn(text.Colored("title", defaultColor, text.B), 10, table.WithAutoFill()),
+		table.NewColumn(text.Colored("from", defaultColor, text.B), 1, table.WithMaxWidth(15)),
+		table.NewColumn(text.Colored("type", defaultColor, text.B), 1.5, table.WithMaxWidth(20)),
+		table.NewColumn(text.Colored("age", defaultColor, text.B), 0.5, table.WithMaxWidth(6)),
 	}
 
+	m.table.SetColumns(cols)
 	m.prjTable.SetColumns(prjColumn)
+	m.viewsTable.SetColumns(viewsColumn)
+	m.inboxTable.SetColumns(inboxCols)
 }
 
 func (m *Model) renderStatusBar() string {
@@ -112,24 +147,42 @@ func (m *Model) renderStatusBar() string {
 	switch m.focus.current() {
 	case FocusFilter:
 		mode = "filter"
-		c = "#752822"
+		c = m.theme.Modes.Filter
 	case FocusSort:
 		mode = "sort"
-		c = "#82783c"
+		c = m.theme.Modes.Sort
+	case FocusGroup:
+		mode = "group"
+		c = m.theme.Modes.Sort
 	case FocusHover:
 		mode = "hover"
-		c = "#40394d"
+		c = m.theme.Modes.Hover
 	case FocusVisual:
 		mode = "visual"
-		c = "#406391"
+		c = m.theme.Modes.Visual
+	case FocusInbox:
+		mode = "inbox"
+		c = m.theme.Modes.Inbox
+	case FocusBoard:
+		mode = "board"
+		c = m.theme.Modes.Normal
+	case FocusDetail:
+		mode = "detail"
+		c = m.theme.Modes.Hover
 	default:
+		if m.currView == ViewArchive {
+			mode = "archive"
+			c = m.theme.Modes.Archive
+			break
+		}
+
 		mode = "tinear"
-		c = "#2D4F67"
+		c = m.theme.Modes.Normal
 	}
 
 	modeChip := text.Chip(
 		mode,
-		color.Simple("#ccc"),
+		color.Simple(m.theme.Modes.Text),
 		color.Simple(c),
 		text.B,
 	).Focused()
@@ -139,14 +192,29 @@ func (m *Model) renderStatusBar() string {
 		m.store.Current().Me.DisplayName,
 		strings.ToLower(m.store.Current().Org.Name),
 	)
-	orgName := text.Colored(name, color.Simple("#777")).Focused()
+	if len(m.config.Workspaces) > 1 {
+		name += fmt.Sprintf(" [%s]", m.client.Workspace())
+	}
+	orgName := text.Colored(name, color.Simple(m.theme.Muted)).Focused()
+
+	var workingOn string
+	if m.workingOn != "" {
+		workingOn = text.Colored(fmt.Sprintf("  working on %s", m.workingOn), color.Simple(m.theme.Positive)).Focused()
+	}
+
+	var unread string
+	if m.unread > 0 {
+		unread = text.Colored(fmt.Sprintf("  %d unread", m.unread), color.Simple(m.theme.Accent), text.B).Focused()
+	}
 
 	var syncedAt string
 	if m.syncing {
-		syncedAt = text.Colored("syncing...", color.Simple("#444")).Focused()
+		syncedAt = text.Colored("syncing...", color.Simple(m.theme.Dim)).Focused()
+	} else if m.searchingRemote {
+		syncedAt = text.Colored("searching linear...", color.Simple(m.theme.Dim)).Focused()
 	} else {
 		syncedAt = text.Colored(
-			fmt.Sprintf("synced at %s", m.store.Current().Org.SyncedAt.Format(time.DateTime)), color.Simple("#444"),
+			fmt.Sprintf("synced at %s", m.store.Current().Org.SyncedAt.Format(time.DateTime)), color.Simple(m.theme.Dim),
 		).Focused()
 	}
 
@@ -156,101 +224,59 @@ func (m *Model) renderStatusBar() string {
 
 	return pad(layouts.SpaceBetween(
 		m.width-3,
-		modeChip+orgName,
+		modeChip+orgName+workingOn+unread,
 		syncedAt,
 	), 1)
 }
 
-func (m *Model) updateTableRows(issues []store.Issue) {
-	rows := make([]*table.Row, 0, len(issues))
+// highlight is text.Colored with the searched terms marked.
+func (m *Model) highlight(value string, fg color.Color, terms []string) text.Focusable {
+	return text.Highlighted(value, fg, color.Focusable(m.theme.Highlight, m.theme.Header), terms)
+}
 
-	renderPrio := func(p store.Prio, brighten float64) text.Focusable {
-		switch p {
-		case 1:
-			return text.Colored("Urgent", color.Focusable("#e03a43", "#888").Brighten(brighten), text.B)
-		case 2:
-			return text.Colored("High", color.Focusable("#d47248", "#888").Brighten(brighten))
-		case 3:
-			return text.Colored("Medium", color.Focusable("#806b38", "#888").Brighten(brighten))
-		case 4:
-			return text.Colored("Low", color.Focusable("#4a4a4a", "#888").Brighten(brighten))
-		}
-		return text.Plain("")
+// searchSnippet shows where the description of the issue under the cursor
+// matched the search.
+func (m *Model) searchSnippet(width int) string {
+	if m.currView == ViewInbox || m.currView == ViewBoard {
+		return ""
 	}
 
-	for _, issue := range issues {
-		titleNormal := text.Colored(issue.Title, color.Focusable("#eee", "#888").Darken(0.2))
-		titleSelected := text.Colored(issue.Title, color.Focusable("#eee", "#888").Brighten(0.2))
-
-		var projectNormal, projectSelected text.Focusable
-		if issue.Project.Name != "" {
-			projectNormal = text.Colored(issue.Project.Name, color.Focusable(issue.Project.Color, "#888"))
-			projectSelected = text.Colored(issue.Project.Name, color.Focusable(issue.Project.Color, "#888").Brighten(0.2))
-		} else {
-			projectNormal = text.Colored("", color.Focusable("#555", "#555"))
-			projectSelected = text.Colored("", color.Focusable("#555", "#555").Brighten(0.2))
+	selected := m.table.SelectedRow()
+	for _, issue := range m.issues {
+		if issue.ID != selected || issue.Match == nil || issue.Match.Snippet == "" {
+			continue
 		}
 
-		var assigneeNormal, assigneeSelected text.Focusable
-		if issue.Assignee.IsMe {
-			assigneeNormal = text.Colored(issue.Assignee.DisplayName, color.Focusable("#76946A", "#888"))
-			assigneeSelected = text.Colored(issue.Assignee.DisplayName, color.Focusable("#76946A", "#888").Brighten(0.2))
-		} else {
-			assigneeNormal = text.Colored(issue.Assignee.DisplayName, color.Focusable("#888", "#888"))
-			assigneeSelected = text.Colored(issue.Assignee.DisplayName, color.Focusable("#888", "#888").Brighten(0.2))
-		}
+		snippet := m.highlight(issue.Match.Snippet, color.Focusable(m.theme.Muted, m.theme.Faint), issue.Match.Terms).Focused()
+		return truncate.StringWithTail(snippet, uint(max(width, 0)), "…")
+	}
 
-		stateNormal := text.Colored(issue.State.Name, color.Focusable(issue.State.Color, "#888"))
-		stateSelected := text.Colored(issue.State.Name, color.Focusable(issue.State.Color, "#888").Brighten(0.2))
-
-		priorityNormal := renderPrio(issue.Priority, 0)
-		prioritySelected := renderPrio(issue.Priority, 0.2)
-
-		teamNormal := text.Colored(issue.Team.Name, color.Focusable(issue.Team.Color, "#888"))
-		teamSelected := text.Colored(issue.Team.Name, color.Focusable(issue.Team.Color, "#888").Brighten(0.2))
-
-		var labelsNormal, labelsSelected []text.Focusable
-		for _, label := range issue.Labels {
-			labelsNormal = append(labelsNormal, text.Chip(
-				label.Name,
-				color.Focusable("#eee", "#888"),
-				color.Focusable(label.Color, "#444").Darken(0.5),
-			))
-			labelsSelected = append(labelsSelected, text.Chip(
-				label.Name,
-				color.Focusable("#eee", "#888").Brighten(0.2),
-				color.Focusable(label.Color, "#444").Darken(0.3),
-			))
-		}
+	return ""
+}
 
-		ageText, ageColor := ageTextAndColor(issue)
-		ageNormal := text.Colored(ageText, ageColor)
-		ageSelected := text.Colored(ageText, ageColor.Brighten(0.2))
+func (m *Model) updateTableRows(issues []store.Issue) {
+	rows := make([]*table.Row, 0, len(issues))
 
-		labelsNormalT := text.Joined("", labelsNormal...)
-		labelsSelectedT := text.Joined("", labelsSelected...)
+	layout := m.layout()
+	grouped := m.grouped()
 
-		pinnedText := ""
-		if issue.Pinned {
-			pinnedText = ""
+	for i, issue := range issues {
+		if grouped && (i == 0 || issues[i-1].Group.Key != issue.Group.Key) {
+			rows = append(rows, m.groupHeader(issues, i))
+		}
+		if grouped && m.collapsed[issue.Group.Key] {
+			continue
 		}
-		pinnedNormal := text.Colored(pinnedText, color.Focusable("#5fa0b8", "#888"), text.B)
-		pinnedSelected := text.Colored(pinnedText, color.Focusable("#5fa0b8", "#888").Brighten(0.2), text.B)
-
-		items := []table.RowItem{
-			{Normal: projectNormal, Selected: projectSelected},
-			{Normal: titleNormal, Selected: titleSelected},
-			{Normal: pinnedNormal, Selected: pinnedSelected},
-			{Normal: assigneeNormal, Selected: assigneeSelected},
-			{Normal: stateNormal, Selected: stateSelected},
-			{Normal: priorityNormal, Selected: prioritySelected},
-			{Normal: ageNormal, Selected: ageSelected},
-			{Normal: teamNormal, Selected: teamSelected},
-			{Normal: labelsNormalT, Selected: labelsSelectedT},
+
+		var terms []string
+		if issue.Match != nil {
+			terms = issue.Match.Terms
 		}
 
-		if m.currView == ViewProject {
-			items = items[1:]
+		items := make([]table.RowItem, 0, len(layout))
+		for _, tc := range layout {
+			c, _ := columnByName(tc.Name)
+			items = append(items, c.render(m, issue, terms))
 		}
 
 		row := &table.Row{
@@ -264,25 +290,156 @@ func (m *Model) updateTableRows(issues []store.Issue) {
 	m.table.SetRows(rows)
 }
 
-func (m *Model) updateProjectsTable(projects []store.Project) {
-	rows := make([]*table.Row, 0, len(projects))
+func (m *Model) updateProjectsTable(projects []store.Project, milestones []store.ProjectMilestone) {
+	th := m.theme
+
+	rows := make([]*table.Row, 0, len(projects)+len(milestones))
+
+	projectMilestones := make(map[string][]store.ProjectMilestone)
+	for _, milestone := range milestones {
+		projectMilestones[milestone.ProjectID] = append(projectMilestones[milestone.ProjectID], milestone)
+	}
 
 	for _, project := range projects {
-		normal := text.Colored(project.Name, color.Focusable(project.Color, "#888"))
-		selected := text.Colored(project.Name, color.Focusable(project.Color, "#888").Brighten(0.2))
+		normal := text.Colored(project.Name, color.Focusable(th.Legible(project.Color), th.Blurred))
+		selected := text.Colored(project.Name, color.Focusable(th.Legible(project.Color), th.Blurred).Brighten(0.2))
+
+		var openIssues string
+		if project.OpenIssues > 0 {
+			openIssues = fmt.Sprint(project.OpenIssues)
+		}
+		openNormal := text.Colored(openIssues, color.Focusable(th.Muted, th.Faint))
+		openSelected := text.Colored(openIssues, color.Focusable(th.Muted, th.Faint).Brighten(0.2))
+
+		var health string
+		if project.Health != "" {
+			health = "●"
+		}
+		healthColor := hover.ProjectHealthColor(project.Health, th)
+		healthNormal := text.Colored(health, color.Focusable(healthColor, th.Faint))
+		healthSelected := text.Colored(health, color.Focusable(healthColor, th.Faint).Brighten(0.2))
 
 		row := &table.Row{
 			Identifier: project.ID,
 			Items: []table.RowItem{
 				{Normal: normal, Selected: selected},
+				{Normal: openNormal, Selected: openSelected},
+				{Normal: healthNormal, Selected: healthSelected},
 			},
 		}
 		rows = append(rows, row)
+
+		for _, milestone := range projectMilestones[project.ID] {
+			name := "  ◇ " + milestone.Name
+			normal := text.Colored(name, color.Focusable(th.Secondary, th.Faint))
+			selected := text.Colored(name, color.Focusable(th.Secondary, th.Faint).Brighten(0.2))
+
+			rows = append(rows, &table.Row{
+				Identifier: milestone.ID,
+				Items: []table.RowItem{
+					{Normal: normal, Selected: selected},
+					{Normal: text.Plain(""), Selected: text.Plain("")},
+					{Normal: text.Plain(""), Selected: text.Plain("")},
+				},
+			})
+		}
 	}
 
 	m.prjTable.SetRows(rows)
 }
 
+func (m *Model) updateCustomViewsTable(customViews []store.CustomView) {
+	th := m.theme
+
+	rows := make([]*table.Row, 0, len(customViews))
+
+	for _, customView := range customViews {
+		name := customView.Name
+		// views that can't be evaluated locally are fetched from linear
+		if !customView.Local() {
+			name += " ↓"
+		}
+
+		normal := text.Colored(name, color.Focusable(th.Legible(customView.Color), th.Blurred))
+		selected := text.Colored(name, color.Focusable(th.Legible(customView.Color), th.Blurred).Brighten(0.2))
+
+		row := &table.Row{
+			Identifier: customView.ID,
+			Items: []table.RowItem{
+				{Normal: normal, Selected: selected},
+			},
+		}
+		rows = append(rows, row)
+	}
+
+	m.viewsTable.SetRows(rows)
+}
+
+func notificationTypeText(notificationType string) string {
+	switch notificationType {
+	case "issueAssignedToYou":
+		return "assigned"
+	case "issueUnassignedFromYou":
+		return "unassigned"
+	case "issueMention", "issueCommentMention":
+		return "mention"
+	case "issueNewComment", "issueCommentReaction":
+		return "comment"
+	case "issueStatusChanged":
+		return "state changed"
+	case "issueCreated":
+		return "created"
+	case "issuePriorityUrgent":
+		return "urgent"
+	case "issueDue":
+		return "due"
+	case "issueBlocking":
+		return "blocking"
+	default:
+		return strings.TrimPrefix(notificationType, "issue")
+	}
+}
+
+func (m *Model) updateInboxTable(notifications []store.Notification) {
+	th := m.theme
+
+	rows := make([]*table.Row, 0, len(notifications))
+
+	for _, notification := range notifications {
+		fg := color.Focusable(th.Text, th.Blurred)
+		if !notification.Unread() {
+			fg = color.Focusable(th.Muted, th.Faint)
+		}
+
+		unreadText := ""
+		if notification.Unread() {
+			unreadText = "●"
+		}
+
+		age := ageText(notification.CreatedAt)
+		notificationType := notificationTypeText(notification.Type)
+
+		unreadNormal := text.Colored(unreadText, color.Focusable(th.Pin, th.Blurred))
+		unreadSelected := text.Colored(unreadText, color.Focusable(th.Pin, th.Blurred).Brighten(0.2))
+
+		row := &table.Row{
+			Identifier: notification.ID,
+			Items: []table.RowItem{
+				{Normal: unreadNormal, Selected: unreadSelected},
+				{Normal: text.Colored(notification.IssueIdentifier, color.Focusable(th.Blurred, th.Faint)), Selected: text.Colored(notification.IssueIdentifier, color.Focusable(th.Blurred, th.Faint).Brighten(0.2))},
+				{Normal: text.Colored(notification.Title, fg.Darken(0.2)), Selected: text.Colored(notification.Title, fg.Brighten(0.2))},
+				{Normal: text.Colored(notification.ActorName, color.Focusable(th.Blurred, th.Blurred)), Selected: text.Colored(notification.ActorName, color.Focusable(th.Blurred, th.Blurred).Brighten(0.2))},
+				{Normal: text.Colored(notificationType, color.Focusable(th.Muted, th.Faint)), Selected: text.Colored(notificationType, color.Focusable(th.Muted, th.Faint).Brighten(0.2))},
+				{Normal: text.Colored(age, color.Focusable(th.Muted, th.Faint)), Selected: text.Colored(age, color.Focusable(th.Muted, th.Faint).Brighten(0.2))},
+			},
+		}
+
+		rows = append(rows, row)
+	}
+
+	m.inboxTable.SetRows(rows)
+}
+
 func (m *Model) View() string {
 	if m.err != nil {
 		return lipgloss.NewStyle().Width(m.width).Render(m.err.Error())
@@ -301,6 +458,15 @@ func (m *Model) View() string {
 
 	issueOffset := m.table.TopOffset() + lipgloss.Height(header) + 1
 
+	switch m.currView {
+	case ViewInbox:
+		issues = m.inboxTable.View()
+		issueOffset = m.inboxTable.TopOffset() + lipgloss.Height(header) + 1
+	case ViewBoard:
+		issues = m.renderBoard(m.width, m.height-4)
+		issueOffset = 3
+	}
+
 	var selectorColOffset, selectorColWidth int
 	var selectorPlaceholder string
 
@@ -335,12 +501,50 @@ func (m *Model) View() string {
 			selectorColOffset = m.table.ColumnOffset("labels")
 			selectorColWidth = m.table.ColumnWidth("labels") - 1
 			selectorPlaceholder = "add/remove labels"
+		case SelectorModeMilestone:
+			selectorColOffset = m.table.ColumnOffset("milestone")
+			selectorColWidth = m.table.ColumnWidth("milestone")
+			selectorPlaceholder = "move to milestone"
+		case SelectorModeWorkspace:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title") / 2
+			selectorPlaceholder = "switch workspace"
+		case SelectorModeConfirm:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title")
+			selectorPlaceholder = "are you sure?"
+		case SelectorModeExport:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title") / 2
+			selectorPlaceholder = "copy as"
+		case SelectorModeSaveFilter:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title") / 2
+			selectorPlaceholder = "save filter as"
+		case SelectorModeSavedFilters:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title")
+			selectorPlaceholder = "saved filters"
+		case SelectorModeGoTo:
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title") / 2
+			selectorPlaceholder = "go to ENG-123, 123 or link"
+		case SelectorModeSnooze:
+			selectorColOffset = m.inboxTable.ColumnOffset("title")
+			selectorColWidth = m.inboxTable.ColumnWidth("title") / 2
+			selectorPlaceholder = "snooze until"
+		}
+		// NOTE: the column edited may not be laid out
+		if selectorColOffset == -1 {
+			selectorColOffset = m.table.ColumnOffset("title")
+			selectorColWidth = m.table.ColumnWidth("title") / 2
 		}
 		m.selector.SetPlaceholder(selectorPlaceholder)
 		m.selector.SetWidth(max(selectorColWidth, 20))
 	}
 
-	if m.currView == ViewProject {
+	switch m.currView {
+	case ViewProject:
 		issues = lipgloss.JoinHorizontal(
 			lipgloss.Left,
 			m.prjTable.View(),
@@ -348,15 +552,36 @@ func (m *Model) View() string {
 		)
 
 		selectorColOffset += projectsTableWidth
+	case ViewCustom:
+		issues = lipgloss.JoinHorizontal(
+			lipgloss.Left,
+			m.viewsTable.View(),
+			issues,
+		)
+
+		selectorColOffset += projectsTableWidth
+	}
+
+	if m.detailShown() {
+		issues = lipgloss.JoinHorizontal(lipgloss.Top, issues, m.renderDetail())
 	}
 
-	filter := pad(m.input.View(), 0, 2)
+	filter := m.input.View()
+	if m.filterErr != nil {
+		// NOTE: columns count from the / in front of the query
+		filter += lipgloss.NewStyle().
+			Foreground(color.Terminal(m.theme.Error)).
+			Render(fmt.Sprintf("  col %d: %s", m.filterErr.Pos+2, m.filterErr.Msg))
+	}
+	filter = pad(filter, 0, 2)
+
+	snippet := pad(m.searchSnippet(m.width-4), 0, 2)
 
 	mainContent := lipgloss.JoinVertical(
 		lipgloss.Left,
 		"",
 		issues,
-		"",
+		snippet,
 		header,
 		filter,
 	)
@@ -369,11 +594,34 @@ func (m *Model) View() string {
 		)
 	}
 
-	if m.hovered == nil {
+	if editor := m.columnEditor(); editor != "" {
+		mainContent = layouts.PlaceOverlay(
+			layouts.NewPosition(m.width-lipgloss.Width(editor)-2, 1),
+			editor,
+			mainContent,
+		)
+	}
+
+	if hint := m.whichKey(); hint != "" {
+		mainContent = layouts.PlaceOverlay(
+			layouts.NewPosition(m.width-lipgloss.Width(hint)-2, lipgloss.Height(mainContent)-lipgloss.Height(hint)-2),
+			hint,
+			mainContent,
+		)
+	}
+
+	var floatingContent string
+
+	switch {
+	case m.hovered != nil:
+		floatingContent = hover.HoverIssue(*m.hovered, m.theme, m.width-2, m.height-3, true)
+	case m.hoveredProject != nil:
+		floatingContent = hover.HoverProject(*m.hoveredProject, m.theme, m.width-2, m.height-3, true)
+		issueOffset = m.prjTable.TopOffset() + lipgloss.Height(header) + 1
+	default:
 		return mainContent
 	}
 
-	floatingContent := hover.HoverIssue(*m.hovered, m.width-2, m.height-3, true)
 	floatingContentHeight := lipgloss.Height(floatingContent)
 
 	// if too close to the bottom
@@ -0,0 +1,60 @@
+package dashboard
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/atoms/box"
+	"github.com/sayedmurtaza24/tinear/pkg/ui/color"
+)
+
+// whichKey lists the keys that can follow the sort, group or edit key while
+// it waits for one of them.
+func (m *Model) whichKey() string {
+	var label string
+	var keys help.KeyMap
+
+	switch m.focus.current() {
+	case FocusSort:
+		label, keys = "sort by", m.keys.SortBy
+	case FocusGroup:
+		label, keys = "group by", m.keys.GroupBy
+	case FocusSelectorPre:
+		label, keys = "edit", m.keys.EditField
+	default:
+		return ""
+	}
+
+	keyStyle := lipgloss.NewStyle().Foreground(color.Terminal(m.theme.Accent)).Bold(true)
+	descStyle := lipgloss.NewStyle().Foreground(color.Terminal(m.theme.Secondary))
+
+	bindings := slices.DeleteFunc(keys.ShortHelp(), func(b key.Binding) bool {
+		return !b.Enabled()
+	})
+
+	keyWidth := 0
+	for _, b := range bindings {
+		keyWidth = max(keyWidth, lipgloss.Width(b.Help().Key))
+	}
+
+	var lines []string
+	for _, b := range bindings {
+		lines = append(lines, keyStyle.Width(keyWidth+2).Render(b.Help().Key)+descStyle.Render(b.Help().Desc))
+	}
+
+	content := strings.Join(lines, "\n")
+
+	return box.New(
+		label,
+		content,
+		lipgloss.Width(content)+2,
+		box.WithBorderStyle(lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(color.Terminal(m.theme.Border)).
+			Padding(0, 1)),
+		box.WithLabelStyle(lipgloss.NewStyle().Foreground(color.Terminal(m.theme.Muted)).Padding(0, 1)),
+	)
+}